@@ -44,10 +44,17 @@ latencyR = {}
 # example: W basic-50 16:50:02.869689 0 0 0 0 0 0 0 -62135596800000 -62135596800000 -62135596800000 -62135596800000 0
 for line in fileinput.input():
     try:
-        kind, name, ts, id, statusCode, traceDepth, riskyAttributeDepth, extraAttributes, spanLength, coolDown, startTS, sendTS, endSendTS, receiveTS, sendReceiveDelta = line.split(" ")
+        kind, name, ts, id, statusCode, traceDepth, riskyAttributeDepth, extraAttributes, spanLength, coolDown, startTS, sendTS, endSendTS, receiveTS, sendReceiveDelta = line.split(" ")[:15]
     except ValueError:
         # Manager logs are shorter, leaving Python to fail unpacking
         continue
+    # Worker logs may carry additional columns after the ones above, which are ignored here.
+    if kind != "W":
+        continue
+    # Only the measurement phase is analyzed, logs written before phases were introduced have no phase column.
+    fields = line.split()
+    if len(fields) > 21 and fields[21] != "measurement":
+        continue
 
     if title == "":
         title = name
@@ -70,7 +77,7 @@ for line in fileinput.input():
         rate[seconds] += 1 / RESAMPLE_SECONDS
         latencyR[seconds].append(int(receiveTS) - int(endSendTS))
 
-    elif statusCode in ['2', '3','4'] and firstError == -1: # Any kind of error
+    elif statusCode in ['2', '3','4', '6'] and firstError == -1: # Any kind of error
         firstError = timestamp - first
         
 
@@ -59,10 +59,17 @@ else:
     # example: W basic-50 16:50:02.869689 0 0 0 0 0 0 0 -62135596800000 -62135596800000 -62135596800000 -62135596800000 0
     for line in fileinput.input():
         try:
-            kind, name, ts, id, statusCode, traceDepth, riskyAttributeDepth, extraAttributes, spanLength, coolDown, startTS, sendTS, endSendTS, receiveTS, sendReceiveDelta = line.split(" ")
+            kind, name, ts, id, statusCode, traceDepth, riskyAttributeDepth, extraAttributes, spanLength, coolDown, startTS, sendTS, endSendTS, receiveTS, sendReceiveDelta = line.split(" ")[:15]
         except ValueError:
             # Manager logs are shorter, leaving Python to fail unpacking
             continue
+        # Worker logs may carry additional columns after the ones above, which are ignored here.
+        if kind != "W":
+            continue
+        # Only the measurement phase is analyzed, logs written before phases were introduced have no phase column.
+        fields = line.split()
+        if len(fields) > 21 and fields[21] != "measurement":
+            continue
 
         # Double parsing to get rid of the hours of the timestamp
         timestamp = datetime.strptime(ts, '%H:%M:%S.%f').timestamp()
@@ -76,7 +83,7 @@ else:
         if rate[name].get(seconds) is None:
             rate[name][seconds] = 0
 
-        if statusCode in ['2', '3','4', '5'] and firstError == -1: # Any kind of error or worker exited
+        if statusCode in ['2', '3','4', '5', '6'] and firstError == -1: # Any kind of error or worker exited
             continue
         elif statusCode == '1': # Successfully sent a trace
             rate[name][seconds] += 1 / RESAMPLE_SECONDS
@@ -0,0 +1,131 @@
+package benchmark
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ldb/openetelemtry-benchmark/config"
+	"github.com/ldb/openetelemtry-benchmark/worker"
+)
+
+// abortInterval is the interval in which abort conditions are evaluated.
+const abortInterval = time.Second
+
+// aborter evaluates the abort conditions of a Benchmark on consecutive Windows.
+type aborter struct {
+	config.Abort
+	// exceeded holds the time since which each threshold has been exceeded continuously.
+	exceeded     map[string]time.Time
+	lastReceived time.Time
+}
+
+func newAborter(a config.Abort, start time.Time) *aborter {
+	return &aborter{Abort: a, exceeded: make(map[string]time.Time), lastReceived: start}
+}
+
+// check returns the reason to abort after w has ended at t, or an empty string if the Benchmark should go on.
+func (a *aborter) check(w worker.Window, t time.Time) string {
+	if w.Received > 0 {
+		a.lastReceived = t
+	}
+	if d := a.NothingReceivedFor.Duration; d > 0 && t.Sub(a.lastReceived) >= d {
+		return fmt.Sprintf("nothing received for %s", d)
+	}
+	if a.MaxRSS > 0 {
+		if r := rss(); r > a.MaxRSS {
+			return fmt.Sprintf("rss %d above %d", r, a.MaxRSS)
+		}
+	}
+	if reason := a.exceed("error rate", a.MaxErrorRate > 0 && w.ErrorRate() > a.MaxErrorRate, t); reason != "" {
+		return fmt.Sprintf("%s above %g", reason, a.MaxErrorRate)
+	}
+	if reason := a.exceed("timeout ratio", a.MaxTimeoutRatio > 0 && w.TimeoutRatio() > a.MaxTimeoutRatio, t); reason != "" {
+		return fmt.Sprintf("%s above %g", reason, a.MaxTimeoutRatio)
+	}
+	if reason := a.exceed("p99", a.MaxP99.Duration > 0 && w.P99 > a.MaxP99.Duration, t); reason != "" {
+		return fmt.Sprintf("%s above %s", reason, a.MaxP99.Duration)
+	}
+	return ""
+}
+
+// exceed records whether threshold is exceeded at t and returns its name once it has been exceeded for a.For.
+func (a *aborter) exceed(threshold string, exceeded bool, t time.Time) string {
+	if !exceeded {
+		delete(a.exceeded, threshold)
+		return ""
+	}
+	since, ok := a.exceeded[threshold]
+	if !ok {
+		since = t
+		a.exceeded[threshold] = t
+	}
+	if t.Sub(since) < a.For.Duration {
+		return ""
+	}
+	return threshold
+}
+
+// watchAbort evaluates the abort conditions of the Benchmark until ctx is canceled, and stops the Benchmark once one is met.
+func (b *Benchmark) watchAbort(ctx context.Context) {
+	ticker := time.NewTicker(abortInterval)
+	defer ticker.Stop()
+	prev := b.workerManager.Snapshot()
+	a := newAborter(b.config.Abort, prev.Time)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		s := b.workerManager.Snapshot()
+		if b.paused() {
+			// Nothing is sent while the Benchmark is paused, which must not count against it once it is resumed.
+			a = newAborter(b.config.Abort, s.Time)
+			prev = s
+			continue
+		}
+		reason := a.check(s.Since(prev), s.Time)
+		prev = s
+		if reason == "" {
+			continue
+		}
+		b.abort(ctx, reason)
+		return
+	}
+}
+
+// abort stops the Benchmark and records reason, unless ctx was canceled because it was stopped in the meantime.
+func (b *Benchmark) abort(ctx context.Context, reason string) {
+	b.m.Lock()
+	defer b.m.Unlock()
+	if ctx.Err() != nil {
+		return
+	}
+	b.aborted = reason
+	b.logger.Println("Abort", reason)
+	log.Printf("aborting benchmark %s: %s", b.Name, reason)
+	if err := b.stop(); err != nil {
+		log.Printf("error stopping benchmark %s: %v", b.Name, err)
+	}
+}
+
+// rss returns the resident memory of the benchd process in bytes.
+// Outside of Linux, the memory obtained from the operating system by the Go runtime is used instead.
+func rss() uint64 {
+	if b, err := os.ReadFile("/proc/self/statm"); err == nil {
+		if fields := strings.Fields(string(b)); len(fields) > 1 {
+			if pages, err := strconv.ParseUint(fields[1], 10, 64); err == nil {
+				return pages * uint64(os.Getpagesize())
+			}
+		}
+	}
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+	return m.Sys
+}
@@ -0,0 +1,53 @@
+package benchmark
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ldb/openetelemtry-benchmark/config"
+	"github.com/ldb/openetelemtry-benchmark/worker"
+)
+
+func TestAborterCheck(t *testing.T) {
+	ok := worker.Window{Received: 100, P99: 10 * time.Millisecond}
+	errs := worker.Window{Received: 50, Errors: 50}
+	timeouts := worker.Window{Received: 50, Timeouts: 50}
+	slow := worker.Window{Received: 100, P99: time.Second}
+	none := worker.Window{}
+	tests := []struct {
+		name    string
+		abort   config.Abort
+		windows []worker.Window
+		// abortAt is the index of the Window that aborts the Benchmark, -1 if none does.
+		abortAt int
+		reason  string
+	}{
+		{name: "within", abort: config.Abort{MaxErrorRate: 0.1, MaxTimeoutRatio: 0.1, MaxP99: config.Duration{Duration: 100 * time.Millisecond}}, windows: []worker.Window{ok, ok, ok}, abortAt: -1},
+		{name: "error rate", abort: config.Abort{MaxErrorRate: 0.1}, windows: []worker.Window{ok, errs}, abortAt: 1, reason: "error rate above 0.1"},
+		{name: "timeout ratio", abort: config.Abort{MaxTimeoutRatio: 0.2}, windows: []worker.Window{timeouts}, abortAt: 0, reason: "timeout ratio above 0.2"},
+		{name: "p99", abort: config.Abort{MaxP99: config.Duration{Duration: 100 * time.Millisecond}}, windows: []worker.Window{ok, ok, slow}, abortAt: 2, reason: "p99 above 100ms"},
+		{name: "error rate for", abort: config.Abort{MaxErrorRate: 0.1, For: config.Duration{Duration: 2 * time.Second}}, windows: []worker.Window{errs, errs, errs, errs}, abortAt: 2, reason: "error rate above 0.1"},
+		{name: "error rate for interrupted", abort: config.Abort{MaxErrorRate: 0.1, For: config.Duration{Duration: 2 * time.Second}}, windows: []worker.Window{errs, errs, ok, errs, errs, ok}, abortAt: -1},
+		{name: "nothing received", abort: config.Abort{NothingReceivedFor: config.Duration{Duration: 3 * time.Second}}, windows: []worker.Window{ok, none, none, none}, abortAt: 3, reason: "nothing received for 3s"},
+		{name: "nothing received since start", abort: config.Abort{NothingReceivedFor: config.Duration{Duration: 2 * time.Second}}, windows: []worker.Window{none, none}, abortAt: 1, reason: "nothing received for 2s"},
+		{name: "something received", abort: config.Abort{NothingReceivedFor: config.Duration{Duration: 2 * time.Second}}, windows: []worker.Window{none, ok, none, ok, none}, abortAt: -1},
+		{name: "no thresholds", abort: config.Abort{}, windows: []worker.Window{errs, timeouts, slow, none}, abortAt: -1},
+	}
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newAborter(tt.abort, start)
+			for i, w := range tt.windows {
+				reason := a.check(w, start.Add(time.Duration(i+1)*abortInterval))
+				switch {
+				case i == tt.abortAt && reason != tt.reason:
+					t.Fatalf("check() of window %d = %q, want %q", i, reason, tt.reason)
+				case i != tt.abortAt && reason != "":
+					t.Fatalf("check() of window %d = %q, want no abort", i, reason)
+				case i == tt.abortAt:
+					return
+				}
+			}
+		})
+	}
+}
@@ -2,10 +2,11 @@ package benchmark
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"github.com/ldb/openetelemtry-benchmark/config"
 	"github.com/ldb/openetelemtry-benchmark/worker"
+	"log"
+	"math"
 	"os"
 	"sync"
 	"time"
@@ -22,6 +23,75 @@ type Benchmark struct {
 	ctx           context.Context
 	cancel        context.CancelFunc
 	logFile       *os.File
+	// logger writes the events of the Benchmark itself to logFile.
+	logger *log.Logger
+	// target is the load a profile currently asks for, in Workers or traces per second.
+	target float64
+	// search is the progress of the capacity search, if one was configured.
+	search *SearchResult
+	// aborted is the reason the Benchmark stopped itself, if it did.
+	aborted string
+	// measurementStart and measurementEnd are the counters of the Manager at the start and the end of the measurement phase.
+	measurementStart *worker.Snapshot
+	measurementEnd   *worker.Snapshot
+	// start is the time the Benchmark was started.
+	start time.Time
+	// closed is set once the Workers are stopped and the log file is closed.
+	closed bool
+	// pausing is closed while the Benchmark is paused, resuming while it is not.
+	pausing  chan struct{}
+	resuming chan struct{}
+	// resumeTo is the state to return to once a paused Benchmark is resumed.
+	resumeTo State
+	// pausedAt is the time the current pause started, pausedFor the duration of all previous pauses.
+	pausedAt  time.Time
+	pausedFor time.Duration
+	// seed is the seed of the workload, which is picked at random if the config does not set one.
+	seed int64
+	// destroyed is closed once the Benchmark is destroyed, see destroyedChan.
+	destroyed chan struct{}
+}
+
+// runProfile adjusts the load to the configured profile every tick until the profile ends or ctx is canceled.
+// Once the profile ends, its last load is kept until the Benchmark is stopped.
+func (b *Benchmark) runProfile(ctx context.Context) error {
+	p, err := newProfile(b.config.Profile)
+	if err != nil {
+		return err
+	}
+	if p.Rate {
+		rate := b.config.ArrivalRate
+		// The number of outstanding traces is limited according to the highest rate of the profile.
+		rate.Rate = p.peak()
+		if err := b.workerManager.StartArrivals(rate); err != nil {
+			return err
+		}
+	}
+	start := time.Now()
+	for {
+		t := b.elapsed(start)
+		if t > p.Duration.Duration {
+			t = p.Duration.Duration
+		}
+		v := p.at(t)
+		b.setLoad(p.Rate, v)
+		b.m.Lock()
+		b.currentStep += 1
+		b.target = v
+		b.m.Unlock()
+		if t == p.Duration.Duration || !b.wait(ctx, p.Tick.Duration) {
+			return nil
+		}
+	}
+}
+
+// setLoad sets the number of Workers, or the arrival rate if rate is set, to level.
+func (b *Benchmark) setLoad(rate bool, level float64) {
+	if rate {
+		b.workerManager.SetArrivalRate(level)
+	} else {
+		b.workerManager.SetWorkers(int(math.Round(level)))
+	}
 }
 
 func (b *Benchmark) Start() error {
@@ -29,34 +99,95 @@ func (b *Benchmark) Start() error {
 	defer b.m.Unlock()
 	if b.config == nil {
 		b.status = Uninitialized
-		return errors.New("uninitialized")
+		return &StateError{State: b.status, Reason: "uninitialized"}
+	}
+	if b.status != Configured || b.closed {
+		return &StateError{State: b.status, Reason: "already running or stopped"}
 	}
-	if b.status == Running || b.status == Stopped {
-		return errors.New("already running or stopped")
+	start := time.Now()
+	if dl := b.config.Deadline; !dl.IsZero() && !dl.After(start) {
+		return &ConfigError{Reason: fmt.Sprintf("deadline %s has already passed", dl)}
 	}
+	b.pausing = make(chan struct{})
+	b.resuming = make(chan struct{})
+	close(b.resuming)
 
 	f, err := os.CreateTemp("", "log-benchd-plan-"+b.Name+"-*")
 	if err != nil {
 		return fmt.Errorf("error creating temporary file: %v", err)
 	}
 	b.logFile = f
+	b.logger = log.New(f, "B "+b.Name+" ", log.Ltime|log.Lmicroseconds|log.LUTC)
 	b.workerManager = worker.NewManager(b.Name, f)
-	b.workerManager.Configure(b.config.WorkerConfig)
+	b.seed = b.config.Seed
+	if b.seed == 0 {
+		b.seed = time.Now().UnixNano()
+	}
+	b.workerManager.SetSeed(b.seed)
+	if err := b.workerManager.Configure(b.config.WorkerConfig); err != nil {
+		// The TLS material may have changed since the Benchmark was configured. It was never started, so nothing is kept.
+		f.Close()
+		os.Remove(f.Name())
+		b.logFile, b.logger, b.workerManager = nil, nil, nil
+		return &ConfigError{Reason: err.Error()}
+	}
 	b.workerManager.Start()
+	b.start = start
 	ctx, cancel := context.WithCancel(context.Background())
 	b.ctx = ctx
 	b.cancel = cancel
+	if b.config.Warmup.Duration > 0 {
+		b.workerManager.SetPhase(worker.PhaseWarmup)
+	} else {
+		b.workerManager.SetPhase(worker.PhaseMeasurement)
+		s := b.workerManager.Snapshot()
+		b.measurementStart = &s
+	}
+	go b.runPhases(ctx, start)
+	if b.hasEnd() {
+		go b.finishAtEnd(ctx, start)
+	}
+	if b.config.Search.Strategy != "" {
+		b.search = &SearchResult{}
+	}
 	go func(ctx context.Context) {
-		// If FixedRate was configured, we run this mode;
+		// If a Search was configured, the load is adjusted until the capacity of the collector is found;
+		if b.search != nil {
+			if err := b.runSearch(ctx); err != nil {
+				b.abort(ctx, fmt.Sprintf("error running search: %v", err))
+				return
+			}
+			b.finish()
+			return
+		}
+		// ... if a Profile was configured, the load follows it until the profile ends;
+		if b.config.Profile.Shape != "" {
+			if err := b.runProfile(ctx); err != nil {
+				b.abort(ctx, fmt.Sprintf("error running profile: %v", err))
+				return
+			}
+			b.finish()
+			return
+		}
+		// ... if ArrivalRate was configured, the Manager issues traces on its own until it is stopped;
+		if b.config.ArrivalRate.Rate > 0 {
+			if err := b.workerManager.StartArrivals(b.config.ArrivalRate); err != nil {
+				b.abort(ctx, fmt.Sprintf("error starting arrivals: %v", err))
+			}
+			return
+		}
+		// ... if FixedRate was configured, we run this mode;
 		if b.config.FixedRate.NumberWorkers > 0 {
 			for {
 				// The benchmark was stopped and we should attempt to create new Workers.
 				if ctx.Err() != nil {
 					return
 				}
+				b.m.Lock()
 				b.currentStep += 1
+				b.m.Unlock()
 				b.workerManager.AddWorkers(b.config.FixedRate.NumberWorkers)
-				time.Sleep(b.config.FixedRate.Duration.Duration)
+				b.wait(ctx, b.config.FixedRate.Duration.Duration)
 			}
 		}
 		// ... otherwise attempt to run Step mode.
@@ -65,31 +196,111 @@ func (b *Benchmark) Start() error {
 			if ctx.Err() != nil {
 				return
 			}
+			b.m.Lock()
 			b.currentStep = i + 1
-			b.workerManager.AddWorkers(step.NumberWorkers)
-			time.Sleep(step.Duration.Duration)
+			b.m.Unlock()
+			if step.TargetWorkers != nil {
+				b.workerManager.SetWorkers(*step.TargetWorkers)
+			} else {
+				b.workerManager.AddWorkers(step.NumberWorkers)
+			}
+			if !b.wait(ctx, step.Duration.Duration) {
+				return
+			}
 		}
-		b.status = Finished
+		b.finish()
 		return
 	}(ctx)
+	if b.config.Abort.Enabled() {
+		go b.watchAbort(ctx)
+	}
 	b.status = Running
 	return nil
 }
 
-func (b *Benchmark) Configure(config *config.BenchConfig) {
+// Configure sets the config of a Benchmark that has not been started yet.
+// It returns a ConfigError if the Workers cannot run with the config.
+func (b *Benchmark) Configure(config *config.BenchConfig) error {
 	b.m.Lock()
 	defer b.m.Unlock()
+	if b.status != Unknown && b.status != Uninitialized && b.status != Configured {
+		return &StateError{State: b.status, Reason: "already started"}
+	}
+	if err := worker.ValidateConfig(config.WorkerConfig); err != nil {
+		return &ConfigError{Reason: err.Error()}
+	}
+	if config.Search.Strategy != "" {
+		if _, err := newSearch(config.Search); err != nil {
+			return &ConfigError{Reason: err.Error()}
+		}
+	}
+	if config.Profile.Shape != "" {
+		if _, err := newProfile(config.Profile); err != nil {
+			return &ConfigError{Reason: err.Error()}
+		}
+	}
 	b.config = config
 	b.status = Configured
+	return nil
+}
+
+// finish marks the Benchmark as finished once its scheduler is done, unless it was stopped in the meantime.
+// A Benchmark with a Duration or a Deadline keeps running until its end, see finishAtEnd.
+func (b *Benchmark) finish() {
+	b.m.Lock()
+	defer b.m.Unlock()
+	if b.hasEnd() {
+		return
+	}
+	switch b.status {
+	case Running:
+		b.status = Finished
+	case Paused:
+		b.resumeTo = Finished
+	}
+}
+
+// finishAtEnd stops the Benchmark at its end and marks it as finished, unless it is stopped before.
+func (b *Benchmark) finishAtEnd(ctx context.Context, start time.Time) {
+	if !b.waitForEnd(ctx, start, b.config.Duration.Duration, 0) {
+		return
+	}
+	b.m.Lock()
+	defer b.m.Unlock()
+	if ctx.Err() != nil {
+		// The Benchmark was stopped in the meantime.
+		return
+	}
+	b.logger.Println("Finish")
+	if err := b.stop(); err != nil {
+		log.Printf("error finishing benchmark %s: %v", b.Name, err)
+		return
+	}
+	b.status = Finished
 }
 
 func (b *Benchmark) Stop() error {
 	b.m.Lock()
 	defer b.m.Unlock()
-	if b.status != Running && b.status != Finished {
-		return errors.New("not running")
+	return b.stop()
+}
+
+// stop stops the Benchmark, b.m must be held.
+func (b *Benchmark) stop() error {
+	if !b.status.Active() {
+		return &StateError{State: b.status, Reason: "not running"}
+	}
+	if b.closed {
+		// The Benchmark already stopped itself at its end.
+		b.status = Stopped
+		return nil
 	}
+	b.closed = true
 	b.cancel()
+	if b.measurementStart != nil && b.measurementEnd == nil {
+		s := b.workerManager.Snapshot()
+		b.measurementEnd = &s
+	}
 	b.workerManager.Stop()
 	if err := b.logFile.Close(); err != nil {
 		return fmt.Errorf("error closing log file: %v", err)
@@ -101,12 +312,25 @@ func (b *Benchmark) Stop() error {
 func (b *Benchmark) Destroy() error {
 	b.m.Lock()
 	defer b.m.Unlock()
-	if b.status != Stopped {
-		if err := b.Stop(); err != nil {
+	if b.status.Active() {
+		if err := b.stop(); err != nil {
 			return fmt.Errorf("error stopping benchmark: %v", err)
 		}
 	}
-	if err := os.Remove(b.logFile.Name()); err != nil {
+	if b.destroyed == nil {
+		b.destroyed = make(chan struct{})
+	}
+	select {
+	case <-b.destroyed:
+	default:
+		close(b.destroyed)
+	}
+	if b.logFile == nil {
+		// The Benchmark was never started.
+		return nil
+	}
+	// The log file is already gone if the Benchmark was destroyed before.
+	if err := os.Remove(b.logFile.Name()); err != nil && !os.IsNotExist(err) {
 		return fmt.Errorf("error deleting logfile %s: %v", b.logFile.Name(), err)
 	}
 
@@ -114,11 +338,51 @@ func (b *Benchmark) Destroy() error {
 }
 
 type Status struct {
+	Name         string        `json:"name"`
 	State        string        `json:"state"`
 	CurrentStep  int           `json:"currentStep"`
 	MaxStep      int           `json:"maxStep"`
 	ManagerState worker.Status `json:"managerState"`
 	LogFile      string        `json:"logFile"`
+	// Target is the load the profile currently asks for, in Workers or traces per second.
+	Target float64 `json:"target,omitempty"`
+	// Search is the progress and result of the capacity search, if one was configured.
+	Search *SearchResult `json:"search,omitempty"`
+	// Aborted is the reason the Benchmark stopped itself because one of its abort conditions was met.
+	Aborted string `json:"aborted,omitempty"`
+	// Phase is the current phase of the Benchmark: warmup, measurement or cooldown.
+	Phase string `json:"phase,omitempty"`
+	// Measurement describes the traces finished during the measurement phase so far, excluding warmup and cooldown.
+	Measurement *worker.Window `json:"measurement,omitempty"`
+	// End is the time at which the Benchmark finishes itself, if it has a duration or a deadline.
+	End *time.Time `json:"end,omitempty"`
+	// Seed is the seed of the generated workload, which reproduces it when set in the config of another run.
+	Seed int64 `json:"seed,omitempty"`
+}
+
+// State returns the current State of the Benchmark.
+func (b *Benchmark) State() State {
+	b.m.RLock()
+	defer b.m.RUnlock()
+	return b.status
+}
+
+// Closed reports whether the Workers of the Benchmark are stopped and its log file is closed,
+// either because it was stopped or because it stopped itself at its end.
+func (b *Benchmark) Closed() bool {
+	b.m.RLock()
+	defer b.m.RUnlock()
+	return b.closed
+}
+
+// LogFile returns the name of the log file of the Benchmark, empty if it has not been started.
+func (b *Benchmark) LogFile() string {
+	b.m.RLock()
+	defer b.m.RUnlock()
+	if b.logFile == nil {
+		return ""
+	}
+	return b.logFile.Name()
 }
 
 func (b *Benchmark) Status() Status {
@@ -126,17 +390,39 @@ func (b *Benchmark) Status() Status {
 	defer b.m.Unlock()
 	if b.status == Uninitialized || b.status == Unknown {
 		b.status = Uninitialized
-		return Status{State: b.status.String()}
+		return Status{Name: b.Name, State: b.status.String()}
 	}
 	s := Status{
+		Name:         b.Name,
 		State:        b.status.String(),
 		CurrentStep:  b.currentStep,
 		MaxStep:      len(b.config.Steps),
 		ManagerState: worker.Status{},
 		LogFile:      "",
+		Target:       b.target,
+		Aborted:      b.aborted,
+		Seed:         b.seed,
+	}
+	if b.hasEnd() {
+		end := b.end()
+		s.End = &end
 	}
 	if b.workerManager != nil {
 		s.ManagerState = b.workerManager.Status()
+		s.Phase = b.workerManager.Phase()
+	}
+	if b.measurementStart != nil {
+		end := b.measurementEnd
+		if end == nil {
+			now := b.workerManager.Snapshot()
+			end = &now
+		}
+		w := end.Since(*b.measurementStart)
+		s.Measurement = &w
+	}
+	if b.search != nil {
+		result := *b.search
+		s.Search = &result
 	}
 	if b.logFile != nil {
 		s.LogFile = b.logFile.Name()
@@ -0,0 +1,91 @@
+package benchmark
+
+import (
+	"context"
+	"time"
+)
+
+// Pause freezes all Workers of a running Benchmark and suspends its scheduler, until it is resumed.
+// Connections to the collector stay open, e.g. to restart the collector with a new configuration mid-run.
+// Its Duration is extended by the time it is paused, while its Deadline stays.
+func (b *Benchmark) Pause() error {
+	b.m.Lock()
+	defer b.m.Unlock()
+	if (b.status != Running && b.status != Finished) || b.closed {
+		return &StateError{State: b.status, Reason: "not running"}
+	}
+	b.workerManager.Pause()
+	b.resumeTo = b.status
+	b.status = Paused
+	b.pausedAt = time.Now()
+	close(b.pausing)
+	b.resuming = make(chan struct{})
+	b.logger.Println("Pause")
+	return nil
+}
+
+// Resume continues a paused Benchmark where it left off.
+func (b *Benchmark) Resume() error {
+	b.m.Lock()
+	defer b.m.Unlock()
+	if b.status != Paused {
+		return &StateError{State: b.status, Reason: "not paused"}
+	}
+	b.pausedFor += time.Since(b.pausedAt)
+	b.status = b.resumeTo
+	close(b.resuming)
+	b.pausing = make(chan struct{})
+	b.workerManager.Resume()
+	b.logger.Println("Resume")
+	return nil
+}
+
+func (b *Benchmark) paused() bool {
+	b.m.RLock()
+	defer b.m.RUnlock()
+	return b.status == Paused
+}
+
+// wait waits for d, not counting the time the Benchmark is paused, and reports whether ctx is still active afterwards.
+func (b *Benchmark) wait(ctx context.Context, d time.Duration) bool {
+	for {
+		b.m.RLock()
+		pausing, resuming := b.pausing, b.resuming
+		b.m.RUnlock()
+		// Wait until the Benchmark is not paused (anymore).
+		select {
+		case <-ctx.Done():
+			return false
+		case <-resuming:
+		}
+		start := time.Now()
+		t := time.NewTimer(d)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return false
+		case <-t.C:
+			return true
+		case <-pausing:
+			t.Stop()
+			d -= time.Since(start)
+		}
+	}
+}
+
+// elapsed returns the time since start, not counting the time the Benchmark was paused.
+// start must not be earlier than the start of the Benchmark.
+func (b *Benchmark) elapsed(start time.Time) time.Duration {
+	b.m.RLock()
+	defer b.m.RUnlock()
+	return time.Since(start) - b.pausedTime()
+}
+
+// pausedTime returns the time the Benchmark has been paused so far, b.m must be held.
+func (b *Benchmark) pausedTime() time.Duration {
+	paused := b.pausedFor
+	if b.status == Paused {
+		paused += time.Since(b.pausedAt)
+	}
+	return paused
+}
@@ -0,0 +1,107 @@
+package benchmark
+
+import (
+	"context"
+	"time"
+
+	"github.com/ldb/openetelemtry-benchmark/worker"
+)
+
+// runPhases moves the Benchmark from warmup to measurement once the warmup is over, and to cooldown before its end.
+// The counters of the Manager are recorded at both transitions, so that Status can report the measurement window.
+// Like the Steps and the Profile, the warmup does not count the time the Benchmark is paused.
+// The end is the end of the Benchmark if it has one, see waitForEnd, otherwise the end of its Steps or Profile.
+func (b *Benchmark) runPhases(ctx context.Context, start time.Time) {
+	if !b.wait(ctx, b.config.Warmup.Duration) {
+		return
+	}
+	b.setPhase(worker.PhaseMeasurement)
+	cooldown := b.config.Cooldown.Duration
+	if cooldown <= 0 {
+		return
+	}
+	d := b.config.Duration.Duration
+	if !b.hasEnd() {
+		if d = b.scheduledDuration(); d <= 0 {
+			return
+		}
+	}
+	if !b.waitForEnd(ctx, start, d, cooldown) {
+		return
+	}
+	b.setPhase(worker.PhaseCooldown)
+}
+
+// hasEnd reports whether the Benchmark finishes itself, because it has a Duration or a Deadline.
+func (b *Benchmark) hasEnd() bool {
+	return b.config.Duration.Duration > 0 || !b.config.Deadline.IsZero()
+}
+
+// end returns the time at which the Benchmark finishes itself, provided it is not paused (anymore). b.m must be held.
+func (b *Benchmark) end() time.Time {
+	var end time.Time
+	if d := b.config.Duration.Duration; d > 0 {
+		end = b.start.Add(d + b.pausedTime())
+	}
+	if dl := b.config.Deadline; !dl.IsZero() && (end.IsZero() || dl.Before(end)) {
+		end = dl
+	}
+	return end
+}
+
+// waitForEnd waits until before ahead of the end of the Benchmark and reports whether ctx is still active afterwards.
+// The end is once the Benchmark ran for d since start, not counting the time it was paused, or its Deadline, whichever comes first.
+// A Deadline is a point in time, so pausing the Benchmark shortens the time left until then.
+func (b *Benchmark) waitForEnd(ctx context.Context, start time.Time, d, before time.Duration) bool {
+	wctx := ctx
+	if dl := b.config.Deadline; !dl.IsZero() {
+		var cancel context.CancelFunc
+		wctx, cancel = context.WithDeadline(ctx, dl.Add(-before))
+		defer cancel()
+	}
+	if d > 0 {
+		b.wait(wctx, d-before-b.elapsed(start))
+	} else {
+		<-wctx.Done()
+	}
+	return ctx.Err() == nil
+}
+
+// setPhase switches the Manager to phase p and records the start or the end of the measurement window.
+func (b *Benchmark) setPhase(p string) {
+	b.m.Lock()
+	defer b.m.Unlock()
+	if b.workerManager.Phase() == p {
+		return
+	}
+	b.workerManager.SetPhase(p)
+	s := b.workerManager.Snapshot()
+	switch p {
+	case worker.PhaseMeasurement:
+		b.measurementStart = &s
+	case worker.PhaseCooldown:
+		b.measurementEnd = &s
+	}
+}
+
+// scheduledDuration returns the time from the start of the Benchmark until its Steps or its Profile end, or 0 if it runs until it is stopped.
+func (b *Benchmark) scheduledDuration() time.Duration {
+	if b.config.Search.Strategy != "" {
+		return 0
+	}
+	if b.config.Profile.Shape != "" {
+		p, err := newProfile(b.config.Profile)
+		if err != nil {
+			return 0
+		}
+		return p.Duration.Duration
+	}
+	if b.config.ArrivalRate.Rate > 0 || b.config.FixedRate.NumberWorkers > 0 {
+		return 0
+	}
+	var d time.Duration
+	for _, step := range b.config.Steps {
+		d += step.Duration.Duration
+	}
+	return d
+}
@@ -0,0 +1,66 @@
+package benchmark
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ldb/openetelemtry-benchmark/config"
+)
+
+func TestBenchmarkEnd(t *testing.T) {
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		duration  time.Duration
+		deadline  time.Time
+		pausedFor time.Duration
+		want      time.Time
+	}{
+		{name: "duration", duration: time.Minute, want: start.Add(time.Minute)},
+		{name: "duration paused", duration: time.Minute, pausedFor: 10 * time.Second, want: start.Add(70 * time.Second)},
+		{name: "deadline", deadline: start.Add(time.Hour), want: start.Add(time.Hour)},
+		{name: "deadline paused", deadline: start.Add(time.Hour), pausedFor: 10 * time.Second, want: start.Add(time.Hour)},
+		{name: "deadline before duration", duration: time.Hour, deadline: start.Add(time.Minute), want: start.Add(time.Minute)},
+		{name: "duration before deadline", duration: time.Minute, deadline: start.Add(time.Hour), want: start.Add(time.Minute)},
+		{name: "pause moves duration past deadline", duration: time.Minute, deadline: start.Add(80 * time.Second), pausedFor: time.Minute, want: start.Add(80 * time.Second)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Benchmark{
+				config:    &config.BenchConfig{Duration: config.Duration{Duration: tt.duration}, Deadline: tt.deadline},
+				start:     start,
+				pausedFor: tt.pausedFor,
+			}
+			if !b.hasEnd() {
+				t.Fatal("hasEnd() = false, want true")
+			}
+			if got := b.end(); !got.Equal(tt.want) {
+				t.Errorf("end() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScheduledDuration(t *testing.T) {
+	steps := []config.BenchmarkStep{{Duration: configDuration(time.Minute)}, {Duration: configDuration(30 * time.Second)}}
+	tests := []struct {
+		name   string
+		config config.BenchConfig
+		want   time.Duration
+	}{
+		{name: "steps", config: config.BenchConfig{Steps: steps}, want: 90 * time.Second},
+		{name: "profile", config: config.BenchConfig{Steps: steps, Profile: config.Profile{Shape: config.ShapeRamp, Duration: configDuration(time.Hour)}}, want: time.Hour},
+		{name: "invalid profile", config: config.BenchConfig{Profile: config.Profile{Shape: config.ShapeRamp}}, want: 0},
+		{name: "search", config: config.BenchConfig{Search: config.Search{Strategy: config.SearchBinary, Max: 10}}, want: 0},
+		{name: "fixed rate", config: config.BenchConfig{Steps: steps, FixedRate: config.FixedRate{NumberWorkers: 1}}, want: 0},
+		{name: "arrival rate", config: config.BenchConfig{Steps: steps, ArrivalRate: config.ArrivalRate{Rate: 10}}, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Benchmark{config: &tt.config}
+			if got := b.scheduledDuration(); got != tt.want {
+				t.Errorf("scheduledDuration() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
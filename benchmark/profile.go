@@ -0,0 +1,106 @@
+package benchmark
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/ldb/openetelemtry-benchmark/config"
+)
+
+// defaultTick is the interval in which the load of a profile is adjusted if the profile does not set one.
+const defaultTick = time.Second
+
+// profile interpolates the load of a config.Profile over time.
+type profile struct {
+	config.Profile
+}
+
+// newProfile checks p and sets its defaults. Loads must not be negative, since they are numbers of Workers or rates.
+func newProfile(p config.Profile) (profile, error) {
+	switch p.Shape {
+	case config.ShapeRamp:
+	case config.ShapeSpike:
+		if p.SpikeDuration.Duration <= 0 {
+			return profile{}, errors.New("spike profile requires a spikeDuration")
+		}
+	case config.ShapeSine:
+		if p.Period.Duration <= 0 {
+			return profile{}, errors.New("sine profile requires a period")
+		}
+	case config.ShapeCSV:
+		if len(p.Points) == 0 {
+			return profile{}, errors.New("csv profile requires points")
+		}
+		for i, pt := range p.Points {
+			if pt.Value < 0 {
+				return profile{}, fmt.Errorf("point %d of csv profile is negative", i+1)
+			}
+			if i > 0 && pt.Offset.Duration < p.Points[i-1].Offset.Duration {
+				return profile{}, errors.New("points of csv profile must be ordered by offset")
+			}
+		}
+		if p.Duration.Duration <= 0 {
+			p.Duration = p.Points[len(p.Points)-1].Offset
+		}
+	default:
+		return profile{}, fmt.Errorf("unknown profile shape %q", p.Shape)
+	}
+	if p.Duration.Duration <= 0 {
+		return profile{}, errors.New("profile requires a duration")
+	}
+	if p.Shape != config.ShapeCSV && (p.From < 0 || p.To < 0) {
+		return profile{}, errors.New("profile requires from and to to be at least 0")
+	}
+	if p.Tick.Duration <= 0 {
+		p.Tick = config.Duration{Duration: defaultTick}
+	}
+	return profile{p}, nil
+}
+
+// at returns the load at offset t from the start of the profile.
+func (p profile) at(t time.Duration) float64 {
+	switch p.Shape {
+	case config.ShapeRamp:
+		f := math.Min(float64(t)/float64(p.Duration.Duration), 1)
+		return p.From + (p.To-p.From)*f
+	case config.ShapeSine:
+		f := float64(t) / float64(p.Period.Duration)
+		return p.From + (p.To-p.From)*(1-math.Cos(2*math.Pi*f))/2
+	case config.ShapeSpike:
+		if t >= p.SpikeStart.Duration && t < p.SpikeStart.Duration+p.SpikeDuration.Duration {
+			return p.To
+		}
+		return p.From
+	default:
+		pp := p.Points
+		if t <= pp[0].Offset.Duration {
+			return pp[0].Value
+		}
+		for i := 1; i < len(pp); i++ {
+			if t > pp[i].Offset.Duration {
+				continue
+			}
+			a, b := pp[i-1], pp[i]
+			if b.Offset.Duration == a.Offset.Duration {
+				return b.Value
+			}
+			f := float64(t-a.Offset.Duration) / float64(b.Offset.Duration-a.Offset.Duration)
+			return a.Value + (b.Value-a.Value)*f
+		}
+		return pp[len(pp)-1].Value
+	}
+}
+
+// peak returns the highest load of the profile.
+func (p profile) peak() float64 {
+	if p.Shape != config.ShapeCSV {
+		return math.Max(p.From, p.To)
+	}
+	peak := p.Points[0].Value
+	for _, pt := range p.Points {
+		peak = math.Max(peak, pt.Value)
+	}
+	return peak
+}
@@ -0,0 +1,136 @@
+package benchmark
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/ldb/openetelemtry-benchmark/config"
+)
+
+// configDuration wraps t in a config.Duration.
+func configDuration(t time.Duration) config.Duration {
+	return config.Duration{Duration: t}
+}
+
+func TestNewProfile(t *testing.T) {
+	points := []config.ProfilePoint{{Offset: configDuration(0), Value: 1}, {Offset: configDuration(10 * time.Second), Value: 5}}
+	tests := []struct {
+		name     string
+		profile  config.Profile
+		error    bool
+		duration time.Duration
+		tick     time.Duration
+	}{
+		{name: "ramp", profile: config.Profile{Shape: config.ShapeRamp, Duration: configDuration(time.Minute), To: 10}, duration: time.Minute, tick: defaultTick},
+		{name: "tick", profile: config.Profile{Shape: config.ShapeRamp, Duration: configDuration(time.Minute), Tick: configDuration(100 * time.Millisecond)}, duration: time.Minute, tick: 100 * time.Millisecond},
+		{name: "sine", profile: config.Profile{Shape: config.ShapeSine, Duration: configDuration(time.Minute), Period: configDuration(10 * time.Second)}, duration: time.Minute, tick: defaultTick},
+		{name: "spike", profile: config.Profile{Shape: config.ShapeSpike, Duration: configDuration(time.Minute), SpikeDuration: configDuration(time.Second)}, duration: time.Minute, tick: defaultTick},
+		{name: "csv duration from last point", profile: config.Profile{Shape: config.ShapeCSV, Points: points}, duration: 10 * time.Second, tick: defaultTick},
+		{name: "csv duration", profile: config.Profile{Shape: config.ShapeCSV, Points: points, Duration: configDuration(time.Minute)}, duration: time.Minute, tick: defaultTick},
+		{name: "unknown shape", profile: config.Profile{Shape: "square", Duration: configDuration(time.Minute)}, error: true},
+		{name: "no shape", profile: config.Profile{Duration: configDuration(time.Minute)}, error: true},
+		{name: "no duration", profile: config.Profile{Shape: config.ShapeRamp}, error: true},
+		{name: "negative from", profile: config.Profile{Shape: config.ShapeRamp, Duration: configDuration(time.Minute), From: -1}, error: true},
+		{name: "negative to", profile: config.Profile{Shape: config.ShapeRamp, Duration: configDuration(time.Minute), To: -1}, error: true},
+		{name: "sine without period", profile: config.Profile{Shape: config.ShapeSine, Duration: configDuration(time.Minute)}, error: true},
+		{name: "spike without duration", profile: config.Profile{Shape: config.ShapeSpike, Duration: configDuration(time.Minute)}, error: true},
+		{name: "csv without points", profile: config.Profile{Shape: config.ShapeCSV, Duration: configDuration(time.Minute)}, error: true},
+		{name: "csv at offset 0 only", profile: config.Profile{Shape: config.ShapeCSV, Points: points[:1]}, error: true},
+		{name: "csv negative", profile: config.Profile{Shape: config.ShapeCSV, Points: []config.ProfilePoint{{Offset: configDuration(0), Value: -1}, {Offset: configDuration(time.Second)}}}, error: true},
+		{name: "csv unordered", profile: config.Profile{Shape: config.ShapeCSV, Points: []config.ProfilePoint{points[1], points[0]}}, error: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := newProfile(tt.profile)
+			if tt.error {
+				if err == nil {
+					t.Fatalf("newProfile() = %+v, want error", p)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("newProfile() error = %v", err)
+			}
+			if p.Duration.Duration != tt.duration {
+				t.Errorf("Duration = %v, want %v", p.Duration.Duration, tt.duration)
+			}
+			if p.Tick.Duration != tt.tick {
+				t.Errorf("Tick = %v, want %v", p.Tick.Duration, tt.tick)
+			}
+		})
+	}
+}
+
+func TestProfileAt(t *testing.T) {
+	ramp := config.Profile{Shape: config.ShapeRamp, Duration: configDuration(10 * time.Second), From: 10, To: 20}
+	sine := config.Profile{Shape: config.ShapeSine, Duration: configDuration(time.Minute), Period: configDuration(4 * time.Second), From: 2, To: 4}
+	spike := config.Profile{Shape: config.ShapeSpike, Duration: configDuration(time.Minute), SpikeStart: configDuration(10 * time.Second), SpikeDuration: configDuration(5 * time.Second), From: 1, To: 100}
+	csv := config.Profile{Shape: config.ShapeCSV, Points: []config.ProfilePoint{
+		{Offset: configDuration(2 * time.Second), Value: 4},
+		{Offset: configDuration(4 * time.Second), Value: 8},
+		{Offset: configDuration(6 * time.Second), Value: 8},
+		{Offset: configDuration(6 * time.Second), Value: 2},
+		{Offset: configDuration(8 * time.Second), Value: 0},
+	}}
+	tests := []struct {
+		name    string
+		profile config.Profile
+		at      time.Duration
+		want    float64
+	}{
+		{name: "ramp start", profile: ramp, at: 0, want: 10},
+		{name: "ramp middle", profile: ramp, at: 5 * time.Second, want: 15},
+		{name: "ramp end", profile: ramp, at: 10 * time.Second, want: 20},
+		{name: "ramp after end", profile: ramp, at: 20 * time.Second, want: 20},
+		{name: "ramp down", profile: config.Profile{Shape: config.ShapeRamp, Duration: configDuration(10 * time.Second), From: 20, To: 10}, at: 2 * time.Second, want: 18},
+		{name: "sine start", profile: sine, at: 0, want: 2},
+		{name: "sine quarter", profile: sine, at: time.Second, want: 3},
+		{name: "sine half", profile: sine, at: 2 * time.Second, want: 4},
+		{name: "sine period", profile: sine, at: 4 * time.Second, want: 2},
+		{name: "spike before", profile: spike, at: 9 * time.Second, want: 1},
+		{name: "spike start", profile: spike, at: 10 * time.Second, want: 100},
+		{name: "spike during", profile: spike, at: 14 * time.Second, want: 100},
+		{name: "spike end", profile: spike, at: 15 * time.Second, want: 1},
+		{name: "csv before first point", profile: csv, at: time.Second, want: 4},
+		{name: "csv at point", profile: csv, at: 4 * time.Second, want: 8},
+		{name: "csv between points", profile: csv, at: 3 * time.Second, want: 6},
+		{name: "csv step", profile: csv, at: 6 * time.Second, want: 8},
+		{name: "csv after step", profile: csv, at: 7 * time.Second, want: 1},
+		{name: "csv after last point", profile: csv, at: 10 * time.Second, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := newProfile(tt.profile)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := p.at(tt.at); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("at(%v) = %v, want %v", tt.at, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProfilePeak(t *testing.T) {
+	tests := []struct {
+		name    string
+		profile config.Profile
+		want    float64
+	}{
+		{name: "ramp up", profile: config.Profile{Shape: config.ShapeRamp, Duration: configDuration(time.Second), From: 1, To: 5}, want: 5},
+		{name: "ramp down", profile: config.Profile{Shape: config.ShapeRamp, Duration: configDuration(time.Second), From: 5, To: 1}, want: 5},
+		{name: "csv", profile: config.Profile{Shape: config.ShapeCSV, Points: []config.ProfilePoint{{Value: 3}, {Offset: configDuration(time.Second), Value: 7}, {Offset: configDuration(2 * time.Second), Value: 2}}}, want: 7},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := newProfile(tt.profile)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := p.peak(); got != tt.want {
+				t.Errorf("peak() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package benchmark
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/ldb/openetelemtry-benchmark/config"
+	"github.com/ldb/openetelemtry-benchmark/worker"
+)
+
+// defaultSoak is the time each level of a search is held if the search does not set one.
+const defaultSoak = time.Minute
+
+// SearchResult reports the progress of an automatic capacity search.
+type SearchResult struct {
+	// Level is the load currently being held.
+	Level float64 `json:"level"`
+	// Capacity is the highest load so far that stayed within the SLO, 0 if none did.
+	Capacity float64 `json:"capacity"`
+	// Levels is the number of levels held so far.
+	Levels int  `json:"levels"`
+	Done   bool `json:"done"`
+}
+
+// newSearch checks s and sets its defaults.
+func newSearch(s config.Search) (config.Search, error) {
+	if s.Strategy != config.SearchBinary && s.Strategy != config.SearchStep {
+		return s, fmt.Errorf("unknown search strategy %q", s.Strategy)
+	}
+	if s.Max <= s.Min || s.Min < 0 {
+		return s, errors.New("search requires 0 <= min < max")
+	}
+	if s.Step <= 0 {
+		s.Step = 1
+	}
+	if s.Soak.Duration <= 0 {
+		s.Soak = config.Duration{Duration: defaultSoak}
+	}
+	return s, nil
+}
+
+// runSearch holds one level of load after another as configured in b.config.Search, until the capacity is found or ctx is canceled.
+// Once the search is done, the load stays at the found capacity until the Benchmark is stopped.
+func (b *Benchmark) runSearch(ctx context.Context) error {
+	s, err := newSearch(b.config.Search)
+	if err != nil {
+		return err
+	}
+	if s.Rate {
+		rate := b.config.ArrivalRate
+		// The number of outstanding traces is limited according to the highest rate of the search.
+		rate.Rate = s.Max
+		if err := b.workerManager.StartArrivals(rate); err != nil {
+			return err
+		}
+	}
+	b.logger.Println("Search", s.Strategy, s.Min, s.Max, s.Step, s.Soak.Duration)
+
+	hold := func(level float64) (bool, error) {
+		return b.holdLevel(ctx, s, level)
+	}
+	if err := searchLevels(s, hold); err != nil {
+		// The Benchmark was stopped during the search.
+		return nil
+	}
+
+	b.m.Lock()
+	b.search.Done = true
+	capacity := b.search.Capacity
+	b.m.Unlock()
+	b.setLoad(s.Rate, capacity)
+	b.logger.Println("Capacity", capacity)
+	return nil
+}
+
+// searchLevels calls hold with one level of load after another according to s.Strategy, until the capacity is found.
+// hold reports whether level stayed within the SLO, searchLevels stops at the first error it returns.
+func searchLevels(s config.Search, hold func(level float64) (bool, error)) error {
+	switch s.Strategy {
+	case config.SearchBinary:
+		lo, hi := s.Min, s.Max
+		for hi-lo > s.Step {
+			level := (lo + hi) / 2
+			if !s.Rate {
+				level = math.Round(level)
+				if level <= lo || level >= hi {
+					break
+				}
+			}
+			ok, err := hold(level)
+			if err != nil {
+				return err
+			}
+			if ok {
+				lo = level
+			} else {
+				hi = level
+			}
+		}
+	case config.SearchStep:
+		for level := s.Min; level <= s.Max; level += s.Step {
+			ok, err := hold(level)
+			if err != nil || !ok {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// holdLevel holds level for s.Soak and reports whether the traces finished in the meantime stayed within s.SLO.
+func (b *Benchmark) holdLevel(ctx context.Context, s config.Search, level float64) (bool, error) {
+	b.setLoad(s.Rate, level)
+	b.m.Lock()
+	b.currentStep += 1
+	b.search.Level = level
+	b.search.Levels += 1
+	b.m.Unlock()
+
+	start := b.workerManager.Snapshot()
+	if !b.wait(ctx, s.Soak.Duration) {
+		return false, ctx.Err()
+	}
+	w := b.workerManager.Snapshot().Since(start)
+
+	violations := sloViolations(s.SLO, w)
+	result := "passed"
+	if len(violations) > 0 {
+		result = "failed " + strings.Join(violations, ",")
+	}
+	b.logger.Printf("Level %g received=%d errors=%d timeouts=%d dropped=%d throughput=%.1f p50=%s p90=%s p99=%s %s",
+		level, w.Received, w.Errors, w.Timeouts, w.Dropped, w.Throughput, w.P50, w.P90, w.P99, result)
+	if len(violations) > 0 {
+		return false, nil
+	}
+	b.m.Lock()
+	if level > b.search.Capacity {
+		b.search.Capacity = level
+	}
+	b.m.Unlock()
+	return true, nil
+}
+
+// sloViolations returns a description of every threshold of slo that w exceeds.
+func sloViolations(slo config.SLO, w worker.Window) []string {
+	var v []string
+	if w.Received == 0 {
+		v = append(v, "nothingReceived")
+	}
+	if slo.P50.Duration > 0 && w.P50 > slo.P50.Duration {
+		v = append(v, "p50")
+	}
+	if slo.P90.Duration > 0 && w.P90 > slo.P90.Duration {
+		v = append(v, "p90")
+	}
+	if slo.P99.Duration > 0 && w.P99 > slo.P99.Duration {
+		v = append(v, "p99")
+	}
+	if slo.MaxErrorRate > 0 && w.ErrorRate() > slo.MaxErrorRate {
+		v = append(v, "errorRate")
+	}
+	return v
+}
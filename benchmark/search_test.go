@@ -0,0 +1,128 @@
+package benchmark
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ldb/openetelemtry-benchmark/config"
+	"github.com/ldb/openetelemtry-benchmark/worker"
+)
+
+func TestNewSearch(t *testing.T) {
+	tests := []struct {
+		name   string
+		search config.Search
+		error  bool
+	}{
+		{name: "binary", search: config.Search{Strategy: config.SearchBinary, Max: 10}},
+		{name: "step", search: config.Search{Strategy: config.SearchStep, Min: 1, Max: 10, Step: 2}},
+		{name: "unknown strategy", search: config.Search{Strategy: "random", Max: 10}, error: true},
+		{name: "no strategy", search: config.Search{Max: 10}, error: true},
+		{name: "max equals min", search: config.Search{Strategy: config.SearchBinary, Min: 5, Max: 5}, error: true},
+		{name: "max below min", search: config.Search{Strategy: config.SearchStep, Min: 5, Max: 1}, error: true},
+		{name: "negative min", search: config.Search{Strategy: config.SearchBinary, Min: -1, Max: 10}, error: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := newSearch(tt.search)
+			if tt.error {
+				if err == nil {
+					t.Fatalf("newSearch() = %+v, want error", s)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("newSearch() error = %v", err)
+			}
+			if s.Step <= 0 || s.Soak.Duration <= 0 {
+				t.Errorf("newSearch() = %+v, want defaults for step and soak", s)
+			}
+		})
+	}
+}
+
+func TestSearchLevels(t *testing.T) {
+	tests := []struct {
+		name     string
+		search   config.Search
+		capacity float64
+		want     []float64
+	}{
+		{name: "binary workers", search: config.Search{Strategy: config.SearchBinary, Max: 100, Step: 1}, capacity: 37, want: []float64{50, 25, 38, 32, 35, 37}},
+		{name: "binary rate", search: config.Search{Strategy: config.SearchBinary, Rate: true, Max: 8, Step: 1}, capacity: 5, want: []float64{4, 6, 5}},
+		{name: "binary rate fractions", search: config.Search{Strategy: config.SearchBinary, Rate: true, Max: 3, Step: 0.5}, capacity: 3, want: []float64{1.5, 2.25, 2.625}},
+		{name: "binary workers stop at rounding", search: config.Search{Strategy: config.SearchBinary, Max: 3, Step: 0.5}, capacity: 3, want: []float64{2}},
+		{name: "binary nothing passes", search: config.Search{Strategy: config.SearchBinary, Max: 10, Step: 1}, capacity: 0, want: []float64{5, 3, 2, 1}},
+		{name: "step", search: config.Search{Strategy: config.SearchStep, Min: 2, Max: 10, Step: 3}, capacity: 6, want: []float64{2, 5, 8}},
+		{name: "step all pass", search: config.Search{Strategy: config.SearchStep, Max: 4, Step: 2}, capacity: 10, want: []float64{0, 2, 4}},
+		{name: "step first fails", search: config.Search{Strategy: config.SearchStep, Min: 5, Max: 10, Step: 1}, capacity: 1, want: []float64{5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var levels []float64
+			hold := func(level float64) (bool, error) {
+				levels = append(levels, level)
+				return level <= tt.capacity, nil
+			}
+			if err := searchLevels(tt.search, hold); err != nil {
+				t.Fatalf("searchLevels() error = %v", err)
+			}
+			if !reflect.DeepEqual(levels, tt.want) {
+				t.Errorf("levels = %v, want %v", levels, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchLevelsError(t *testing.T) {
+	stopped := errors.New("stopped")
+	for _, strategy := range []string{config.SearchBinary, config.SearchStep} {
+		t.Run(strategy, func(t *testing.T) {
+			var levels int
+			hold := func(level float64) (bool, error) {
+				levels++
+				if levels == 2 {
+					return false, stopped
+				}
+				return true, nil
+			}
+			err := searchLevels(config.Search{Strategy: strategy, Max: 100, Step: 1}, hold)
+			if !errors.Is(err, stopped) {
+				t.Errorf("searchLevels() error = %v, want %v", err, stopped)
+			}
+			if levels != 2 {
+				t.Errorf("searchLevels() held %d levels after the error, want 2", levels)
+			}
+		})
+	}
+}
+
+func TestSLOViolations(t *testing.T) {
+	slo := config.SLO{
+		P50:          config.Duration{Duration: 10 * time.Millisecond},
+		P90:          config.Duration{Duration: 50 * time.Millisecond},
+		P99:          config.Duration{Duration: 100 * time.Millisecond},
+		MaxErrorRate: 0.1,
+	}
+	tests := []struct {
+		name   string
+		slo    config.SLO
+		window worker.Window
+		want   []string
+	}{
+		{name: "within", slo: slo, window: worker.Window{Received: 100, Errors: 5, P50: 10 * time.Millisecond, P90: 50 * time.Millisecond, P99: 100 * time.Millisecond}},
+		{name: "nothing received", slo: config.SLO{}, window: worker.Window{}, want: []string{"nothingReceived"}},
+		{name: "latencies", slo: slo, window: worker.Window{Received: 100, P50: 11 * time.Millisecond, P90: 51 * time.Millisecond, P99: 101 * time.Millisecond}, want: []string{"p50", "p90", "p99"}},
+		{name: "errors", slo: slo, window: worker.Window{Received: 80, Errors: 10, Dropped: 10}, want: []string{"errorRate"}},
+		{name: "no thresholds", slo: config.SLO{}, window: worker.Window{Received: 1, Errors: 100, P99: time.Minute}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sloViolations(tt.slo, tt.window); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("sloViolations() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ const (
 	Running
 	Finished
 	Stopped
+	Paused
 )
 
 var stateNames = [...]string{
@@ -18,6 +19,7 @@ var stateNames = [...]string{
 	"running",
 	"finished",
 	"stopped",
+	"paused",
 }
 
 func (s State) String() string {
@@ -27,6 +29,30 @@ func (s State) String() string {
 	return stateNames[s]
 }
 
+// Active reports whether the Workers of a Benchmark in State s are running.
+func (s State) Active() bool {
+	return s == Running || s == Paused || s == Finished
+}
+
+// StateError is returned when an operation is not possible in the current State of a Benchmark.
+type StateError struct {
+	State  State
+	Reason string
+}
+
+func (e *StateError) Error() string {
+	return e.Reason
+}
+
+// ConfigError is returned when a Benchmark cannot run with its config.
+type ConfigError struct {
+	Reason string
+}
+
+func (e *ConfigError) Error() string {
+	return e.Reason
+}
+
 func StateFrom(s string) State {
 	for k, name := range stateNames {
 		if name == s {
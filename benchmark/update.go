@@ -0,0 +1,89 @@
+package benchmark
+
+import (
+	"context"
+	"time"
+
+	"github.com/ldb/openetelemtry-benchmark/worker"
+)
+
+// Update is the Status of a Benchmark at a point in time, as sent to subscribers by Updates.
+type Update struct {
+	Status
+	// Window describes the traces finished since the previous Update, including send and receive rates and latency percentiles.
+	// It is nil while the Benchmark has not been started.
+	Window *worker.Window `json:"window,omitempty"`
+}
+
+// Updates sends an Update of the Benchmark right away and then every interval, until ctx is canceled or the Benchmark is done:
+// once it is stopped, once it stopped itself at its end, or once it is destroyed.
+// The Update for the done Benchmark is the last one, after which the returned channel is closed.
+// Updates other than the last one are dropped while the receiver is not ready to take them.
+func (b *Benchmark) Updates(ctx context.Context, interval time.Duration) <-chan Update {
+	updates := make(chan Update, 1)
+	destroyed := b.destroyedChan()
+	go func() {
+		defer close(updates)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		var prev *worker.Snapshot
+		for {
+			u := Update{Status: b.Status()}
+			if m := b.manager(); m != nil {
+				s := m.Snapshot()
+				if prev != nil {
+					w := s.Since(*prev)
+					u.Window = &w
+				}
+				prev = &s
+			}
+			if b.done(destroyed) {
+				select {
+				case updates <- u:
+				case <-ctx.Done():
+				}
+				return
+			}
+			select {
+			case updates <- u:
+			default:
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-destroyed:
+			case <-ticker.C:
+			}
+		}
+	}()
+	return updates
+}
+
+// done reports whether no more Updates of the Benchmark will follow, because it is closed, no longer active, or destroyed.
+func (b *Benchmark) done(destroyed <-chan struct{}) bool {
+	select {
+	case <-destroyed:
+		return true
+	default:
+	}
+	b.m.RLock()
+	defer b.m.RUnlock()
+	return b.closed || b.status == Stopped
+}
+
+// destroyedChan returns a channel that is closed once the Benchmark is destroyed.
+func (b *Benchmark) destroyedChan() <-chan struct{} {
+	b.m.Lock()
+	defer b.m.Unlock()
+	if b.destroyed == nil {
+		b.destroyed = make(chan struct{})
+	}
+	return b.destroyed
+}
+
+// manager returns the worker.Manager of the Benchmark, nil if it has not been started.
+func (b *Benchmark) manager() *worker.Manager {
+	b.m.RLock()
+	defer b.m.RUnlock()
+	return b.workerManager
+}
@@ -1,30 +1,40 @@
 package main
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"github.com/ghodss/yaml"
+	"github.com/ldb/openetelemtry-benchmark/command"
 	"github.com/ldb/openetelemtry-benchmark/config"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"strings"
 )
 
 const (
 	defaultCommandPort    = ":7666"
 	defaultReceiverPort   = ":2113"
 	defaultTargetPort     = ":4317"
+	defaultTargetHTTPPort = ":4318"
 	defaultMonitoringPort = ":9090"
 )
 
-func createPlan(configFilename, planFilename string) (config.BenchmarkPlan, error) {
+func readControlConfig(configFilename string) (config.ControlConfig, error) {
 	cf, err := os.Open(configFilename)
 	if err != nil {
-		return config.BenchmarkPlan{}, fmt.Errorf("error opening config file %q: %v", configFilename, err)
+		return config.ControlConfig{}, fmt.Errorf("error opening config file %q: %v", configFilename, err)
 	}
 	defer cf.Close()
 	ctlConfig, err := config.NewFrom(cf)
 	if err != nil {
-		return config.BenchmarkPlan{}, fmt.Errorf("error parsing config file %q: %v", configFilename, err)
+		return config.ControlConfig{}, fmt.Errorf("error parsing config file %q: %v", configFilename, err)
 	}
+	return ctlConfig, nil
+}
+
+func createPlan(ctlConfig config.ControlConfig, planFilename string) (config.BenchmarkPlan, error) {
 	pf, err := os.Open(planFilename)
 	if err != nil {
 		return config.BenchmarkPlan{}, fmt.Errorf("error opening plan file %q: %v", planFilename, err)
@@ -40,10 +50,80 @@ func createPlan(configFilename, planFilename string) (config.BenchmarkPlan, erro
 	}
 	plan.MonitoringEndpoint = ctlConfig.Monitoring + defaultMonitoringPort
 	plan.BenchConfig.WorkerConfig.Target = ctlConfig.Target + defaultTargetPort
+	if strings.HasPrefix(plan.BenchConfig.WorkerConfig.Protocol, "http/") {
+		// OTLP/HTTP is served on a different port by the collector's OTLP receiver.
+		plan.BenchConfig.WorkerConfig.Target = ctlConfig.Target + defaultTargetHTTPPort
+	}
 	plan.BenchConfig.WorkerConfig.ReceiverAddress = defaultReceiverPort
+	if plan.BenchConfig.Duration.Duration == 0 {
+		// benchd ends the benchmark on its own, so that it does not run forever if benchctl goes away.
+		plan.BenchConfig.Duration = plan.Duration
+	}
+	if err := loadProfileCSV(&plan.BenchConfig.Profile, filepath.Dir(planFilename)); err != nil {
+		return config.BenchmarkPlan{}, err
+	}
 
 	// At the moment we only support a single benchmarking client making requests, as we otherwise need some kind of routing on the SUT.
 	// So we simply take the first one.
-	plan.ClientAddress = "http://" + ctlConfig.Clients[0] + defaultCommandPort
+	scheme := "http://"
+	if usesTLS(ctlConfig) {
+		scheme = "https://"
+	}
+	plan.ClientAddress = scheme + ctlConfig.Clients[0] + defaultCommandPort
 	return plan, nil
 }
+
+func usesTLS(c config.ControlConfig) bool {
+	return c.CA != "" || c.Cert != ""
+}
+
+// clientOptions returns the options of a command.Client for the credentials in c.
+func clientOptions(c config.ControlConfig) ([]command.ClientOption, error) {
+	var opts []command.ClientOption
+	if c.Token != "" {
+		opts = append(opts, command.WithToken(c.Token))
+	}
+	if !usesTLS(c) {
+		return opts, nil
+	}
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if c.CA != "" {
+		pem, err := os.ReadFile(c.CA)
+		if err != nil {
+			return nil, fmt.Errorf("error reading certificate authority %q: %v", c.CA, err)
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %q", c.CA)
+		}
+	}
+	if c.Cert != "" {
+		cert, err := tls.LoadX509KeyPair(c.Cert, c.Key)
+		if err != nil {
+			return nil, fmt.Errorf("error loading client certificate %q: %v", c.Cert, err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return append(opts, command.WithTLS(tlsConfig)), nil
+}
+
+// loadProfileCSV reads the points of p from its CSV file, which is relative to dir, unless they are given already.
+// The points are sent to benchd as part of the plan, so that the file does not need to exist there.
+func loadProfileCSV(p *config.Profile, dir string) error {
+	if p.Shape != config.ShapeCSV || p.CSV == "" || len(p.Points) > 0 {
+		return nil
+	}
+	filename := p.CSV
+	if !filepath.IsAbs(filename) {
+		filename = filepath.Join(dir, filename)
+	}
+	f, err := os.Open(filename)
+	if err != nil {
+		return fmt.Errorf("error opening profile file %q: %v", filename, err)
+	}
+	defer f.Close()
+	if p.Points, err = config.ParseProfileCSV(f); err != nil {
+		return fmt.Errorf("error parsing profile file %q: %v", filename, err)
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"bufio"
+	"context"
 	"flag"
 	"fmt"
 	"log"
@@ -18,6 +19,8 @@ import (
 var (
 	configFlag = flag.String("config", "benchctl.config", "config file generated by terraform")
 	planFlag   = flag.String("plan", "", "benchmarking plan to execute")
+	detachFlag = flag.Bool("detach", false, "exit after starting the plan, benchd finishes it on its own")
+	attachFlag = flag.Bool("attach", false, "follow the plan after it was started with -detach instead of starting it")
 )
 
 func main() {
@@ -27,72 +30,115 @@ func main() {
 		return
 	}
 
-	plan, err := createPlan(*configFlag, *planFlag)
+	ctlConfig, err := readControlConfig(*configFlag)
 	if err != nil {
-		log.Fatalf("error generating benchmarking plan: %v", err)
+		log.Fatalf("error reading config: %v", err)
 	}
-
-	reader := bufio.NewReader(os.Stdin)
-	fmt.Printf("parsed the following plan:\n%+v\n", plan)
-	fmt.Println("do you want to apply this plan? [Y/n]")
-	t, _ := reader.ReadString('\n')
-	if t != "yes\n" && t != "y\n" && t != "\n" {
-		fmt.Println("ok, aborting")
-		return
-	}
-	fmt.Printf("applying plan %q\n", plan.Name)
-	client := command.NewClient(plan.ClientAddress)
-	status, err := client.CreateBenchmark(plan.Name)
+	plan, err := createPlan(ctlConfig, *planFlag)
 	if err != nil {
-		log.Fatalf("error creating benchmark: %v", err)
+		log.Fatalf("error generating benchmarking plan: %v", err)
 	}
-	status, err = client.ConfigureBenchmark(plan.Name, plan.BenchConfig)
+	opts, err := clientOptions(ctlConfig)
 	if err != nil {
-		log.Fatalf("error configuring benchmark: %v", err)
-	}
-	if status.State != benchmark.Configured.String() {
-		log.Fatalf("benchmark not configured. current state: %+v", status)
+		log.Fatalf("error configuring credentials: %v", err)
 	}
-	fmt.Println("plan applied.")
-	fmt.Println("do you want to start this plan? [Y/n]")
-	t, _ = reader.ReadString('\n')
-	if t != "yes\n" && t != "y\n" && t != "\n" {
-		fmt.Println("ok, aborting")
-		return
+
+	reader := bufio.NewReader(os.Stdin)
+	client := command.NewClient(plan.ClientAddress, opts...)
+	var status benchmark.Status
+	if *attachFlag {
+		status, err = client.Status(plan.Name)
+		if err != nil {
+			log.Fatalf("error getting benchmark status: %v", err)
+		}
+		fmt.Printf("attached to plan %q\n", plan.Name)
+	} else {
+		fmt.Printf("parsed the following plan:\n%+v\n", plan)
+		fmt.Println("do you want to apply this plan? [Y/n]")
+		t, _ := reader.ReadString('\n')
+		if t != "yes\n" && t != "y\n" && t != "\n" {
+			fmt.Println("ok, aborting")
+			return
+		}
+		fmt.Printf("applying plan %q\n", plan.Name)
+		status, err = client.CreateBenchmark(plan.Name)
+		if err != nil {
+			log.Fatalf("error creating benchmark: %v", err)
+		}
+		status, err = client.ConfigureBenchmark(plan.Name, plan.BenchConfig)
+		if err != nil {
+			log.Fatalf("error configuring benchmark: %v", err)
+		}
+		if status.State != benchmark.Configured.String() {
+			log.Fatalf("benchmark not configured. current state: %+v", status)
+		}
+		fmt.Println("plan applied.")
+		fmt.Println("do you want to start this plan? [Y/n]")
+		t, _ = reader.ReadString('\n')
+		if t != "yes\n" && t != "y\n" && t != "\n" {
+			fmt.Println("ok, aborting")
+			return
+		}
+		status, err = client.StartBenchmark(plan.Name)
+		if err != nil {
+			log.Fatalf("error starting benchmark: %v", err)
+		}
+		if status.State != benchmark.Running.String() {
+			log.Fatalf("benchmark not running. current state: %+v", status)
+		}
+		if *detachFlag {
+			if status.End != nil {
+				fmt.Printf("plan started, benchd finishes it at %s.\n", status.End.Format(time.RFC3339))
+			}
+			fmt.Println("use -attach to follow the plan.")
+			return
+		}
 	}
 
-	c := make(chan os.Signal)
+	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
-	fmt.Println("starting plan. use ^C to stop the plan")
-
-	status, err = client.StartBenchmark(plan.Name)
+	fmt.Println("following plan. use ^C to stop the plan")
+	ctx, cancel := context.WithCancel(context.Background())
+	updates, err := client.Subscribe(ctx, plan.Name, time.Second)
 	if err != nil {
-		log.Fatalf("error starting benchmark: %v", err)
-	}
-	if status.State != benchmark.Running.String() {
-		log.Fatalf("benchmark not running. current state: %+v", status)
+		log.Fatalf("error subscribing to benchmark status: %v", err)
 	}
-	planDuration := time.After(plan.Duration.Duration)
+	// benchd finishes the plan on its own once its duration is over.
 outer:
-	for {
+	for status.State == benchmark.Running.String() || status.State == benchmark.Paused.String() {
 		select {
-		case <-planDuration:
-			fmt.Println("plan finished.")
-			break outer
-		case <-time.Tick(time.Second):
-			status, err = client.Status(plan.Name)
-			if err != nil {
-				log.Fatalf("error getting benchmark status: %v", err)
+		case u, ok := <-updates:
+			if !ok {
+				// The stream ended early, e.g. because the connection was lost.
+				status, err = client.Status(plan.Name)
+				if err != nil {
+					log.Fatalf("error getting benchmark status: %v", err)
+				}
+				break outer
 			}
+			status = u.Status
 			fmt.Printf("%+v\n", status)
+			if w := u.Window; w != nil {
+				fmt.Printf("sent %.1f/s received %.1f/s errors %d timeouts %d p50 %s p90 %s p99 %s\n",
+					w.SendRate, w.Throughput, w.Errors, w.Timeouts, w.P50, w.P90, w.P99)
+			}
 		case <-c:
 			fmt.Println("\r received signal. stopping plan..")
 			break outer
 		}
 	}
-	status, err = client.StopBenchmark(plan.Name)
-	if err != nil {
-		log.Fatalf("error stopping benchmark: %v", err)
+	cancel()
+	switch {
+	case status.Aborted != "":
+		fmt.Printf("plan aborted: %s\n", status.Aborted)
+	case status.State == benchmark.Finished.String():
+		fmt.Println("plan finished.")
+	}
+	if status.State != benchmark.Stopped.String() {
+		status, err = client.StopBenchmark(plan.Name)
+		if err != nil {
+			log.Fatalf("error stopping benchmark: %v", err)
+		}
 	}
 	if status.State != benchmark.Stopped.String() {
 		log.Fatalf("benchmark not stopped. current state: %+v", status)
@@ -102,9 +148,12 @@ outer:
 	logFile := strings.Split(status.LogFile, "/")
 	logsURL := plan.ClientAddress + "/logs/" + logFile[2]
 	fmt.Printf("plan stopped. you can download the logs here: %s\n", logsURL)
+	if ctlConfig.Token != "" {
+		fmt.Printf("the download requires the token from %s, e.g. curl -H \"Authorization: Bearer <token>\" %s\n", *configFlag, logsURL)
+	}
 
 	fmt.Println("do you want to destroy the plan? \033[31m WARNING THIS WILL DESTROY YOUR LOG FILES \033[0m. Proceed? [y/N]")
-	t, _ = reader.ReadString('\n')
+	t, _ := reader.ReadString('\n')
 	if t != "yes\n" && t != "y\n" {
 		fmt.Println("ok, aborting")
 		return
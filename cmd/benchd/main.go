@@ -1,14 +1,81 @@
 package main
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"flag"
+	"fmt"
 	"github.com/ldb/openetelemtry-benchmark/command"
+	"github.com/ldb/openetelemtry-benchmark/config"
 	"log"
+	"os"
+)
+
+var (
+	grpcHostFlag   = flag.String("grpc", ":7667", "address of the gRPC control service, empty to disable it")
+	maxRunningFlag = flag.Int("max-running", 0, "maximum number of benchmarks running at the same time, 0 for no limit")
+	tokensFlag     = flag.String("tokens", "", "file with the accepted tokens, each line a role (reader or operator) and a token")
+	tlsCertFlag    = flag.String("tls-cert", "", "PEM file with the server certificate, enables TLS")
+	tlsKeyFlag     = flag.String("tls-key", "", "PEM file with the key of the server certificate")
+	clientCAFlag   = flag.String("client-ca", "", "PEM file with the certificate authority of client certificates, requires -tls-cert")
 )
 
 func main() {
-	cmdServer := command.Server{Host: ":7666"}
+	flag.Parse()
+	cmdServer := command.Server{Host: ":7666", GRPCHost: *grpcHostFlag, MaxRunning: *maxRunningFlag}
+	if *tokensFlag != "" {
+		tokens, err := readTokens(*tokensFlag)
+		if err != nil {
+			log.Fatalf("error reading tokens: %v", err)
+		}
+		cmdServer.Tokens = tokens
+	}
+	tlsConfig, err := serverTLS(*tlsCertFlag, *tlsKeyFlag, *clientCAFlag)
+	if err != nil {
+		log.Fatalf("error configuring TLS: %v", err)
+	}
+	cmdServer.TLSConfig = tlsConfig
 	log.Println("listening on port", cmdServer.Host)
 	if err := cmdServer.Start(); err != nil {
 		log.Fatalf("error listening: %v", err)
 	}
 }
+
+func readTokens(filename string) (map[string]string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("error opening tokens file %q: %v", filename, err)
+	}
+	defer f.Close()
+	return config.NewTokensFrom(f)
+}
+
+// serverTLS returns the TLS config for the certificate and key files, nil if no certificate is given.
+// Clients may present a certificate signed by the certificate authority in caFile, which is then verified.
+func serverTLS(certFile, keyFile, caFile string) (*tls.Config, error) {
+	if certFile == "" {
+		if caFile != "" {
+			return nil, errors.New("client certificates require a server certificate")
+		}
+		return nil, nil
+	}
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("error loading certificate: %v", err)
+	}
+	c := &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}
+	if caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading client certificate authority: %v", err)
+		}
+		c.ClientCAs = x509.NewCertPool()
+		if !c.ClientCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %q", caFile)
+		}
+		// Clients without a certificate can still authenticate with a token.
+		c.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+	return c, nil
+}
@@ -0,0 +1,183 @@
+package command
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"github.com/ldb/openetelemtry-benchmark/config"
+	"github.com/ldb/openetelemtry-benchmark/controlpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"strings"
+)
+
+// role is what a client is allowed to do, each role includes the ones before it.
+type role int
+
+const (
+	roleNone role = iota
+	roleReader
+	roleOperator
+)
+
+// readerMethods are the gRPC methods that do not change any Benchmark.
+var readerMethods = map[string]bool{
+	"/" + controlpb.Control_ServiceDesc.ServiceName + "/ListBenchmarks": true,
+	"/" + controlpb.Control_ServiceDesc.ServiceName + "/GetStatus":      true,
+	"/" + controlpb.Control_ServiceDesc.ServiceName + "/WatchStatus":    true,
+	"/" + controlpb.Control_ServiceDesc.ServiceName + "/FetchResults":   true,
+}
+
+// authenticator determines the role of a client, from a bearer token or from a verified client certificate.
+// If it knows neither tokens nor certificates, every client is an operator.
+type authenticator struct {
+	tokens map[string]role
+	// certificates is set if verified client certificates grant a role.
+	certificates bool
+}
+
+// newAuthenticator creates an authenticator for the tokens, which map to the name of a role as read by config.NewTokensFrom.
+func newAuthenticator(tokens map[string]string, certificates bool) (*authenticator, error) {
+	a := &authenticator{tokens: make(map[string]role, len(tokens)), certificates: certificates}
+	for token, name := range tokens {
+		r, ok := parseRole(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown role %q", name)
+		}
+		a.tokens[token] = r
+	}
+	return a, nil
+}
+
+func parseRole(name string) (role, bool) {
+	switch name {
+	case config.RoleReader:
+		return roleReader, true
+	case config.RoleOperator:
+		return roleOperator, true
+	}
+	return roleNone, false
+}
+
+func (a *authenticator) enabled() bool {
+	return len(a.tokens) > 0 || a.certificates
+}
+
+// role returns the role of a client that sent the value of an Authorization header and presented the verified certificate chains.
+// A token takes precedence over a certificate, an invalid token grants no role at all.
+func (a *authenticator) role(authorization string, chains [][]*x509.Certificate) role {
+	if !a.enabled() {
+		return roleOperator
+	}
+	if authorization != "" {
+		token := strings.TrimPrefix(authorization, "Bearer ")
+		if token == authorization {
+			return roleNone
+		}
+		// Compare all tokens in constant time, so that the response time does not tell how much of a token is right.
+		r := roleNone
+		for t, tr := range a.tokens {
+			if subtle.ConstantTimeCompare([]byte(t), []byte(token)) == 1 {
+				r = tr
+			}
+		}
+		return r
+	}
+	if a.certificates && len(chains) > 0 && len(chains[0]) > 0 {
+		for _, ou := range chains[0][0].Subject.OrganizationalUnit {
+			if ou == config.RoleOperator {
+				return roleOperator
+			}
+		}
+		return roleReader
+	}
+	return roleNone
+}
+
+// authorize is an HTTP middleware that only passes on requests of clients with at least the role returned by required.
+func (a *authenticator) authorize(required func(*http.Request) role, handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		var chains [][]*x509.Certificate
+		if request.TLS != nil {
+			chains = request.TLS.VerifiedChains
+		}
+		r := a.role(request.Header.Get("Authorization"), chains)
+		if r == roleNone {
+			writer.Header().Set("WWW-Authenticate", "Bearer")
+			writeError(writer, http.StatusUnauthorized, CodeUnauthorized, "missing or invalid credentials")
+			return
+		}
+		if r < required(request) {
+			writeError(writer, http.StatusForbidden, CodeForbidden, fmt.Sprintf("not allowed to %s %s", request.Method, request.URL.Path))
+			return
+		}
+		handler.ServeHTTP(writer, request)
+	})
+}
+
+// readOnly requires readers for requests that do not change anything and operators for all others.
+func readOnly(request *http.Request) role {
+	if request.Method == http.MethodGet || request.Method == http.MethodHead {
+		return roleReader
+	}
+	return roleOperator
+}
+
+// reader requires readers for all requests.
+func reader(*http.Request) role {
+	return roleReader
+}
+
+// authorizeRPC returns a gRPC status error unless the client of ctx may call method.
+func (a *authenticator) authorizeRPC(ctx context.Context, method string) error {
+	var authorization string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get("authorization"); len(v) > 0 {
+			authorization = v[0]
+		}
+	}
+	var chains [][]*x509.Certificate
+	if p, ok := peer.FromContext(ctx); ok {
+		if info, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			chains = info.State.VerifiedChains
+		}
+	}
+	required := roleOperator
+	if readerMethods[method] {
+		required = roleReader
+	}
+	r := a.role(authorization, chains)
+	if r == roleNone {
+		return status.Error(codes.Unauthenticated, "missing or invalid credentials")
+	}
+	if r < required {
+		return status.Errorf(codes.PermissionDenied, "not allowed to call %s", method)
+	}
+	return nil
+}
+
+func (a *authenticator) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := a.authorizeRPC(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (a *authenticator) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := a.authorizeRPC(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// verifiesClients reports whether a server using config verifies the certificates that clients present.
+func verifiesClients(config *tls.Config) bool {
+	return config != nil && config.ClientCAs != nil && config.ClientAuth >= tls.VerifyClientCertIfGiven
+}
@@ -1,27 +1,66 @@
 package command
 
 import (
+	"bufio"
 	"bytes"
+	"context"
+	"crypto/tls"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/ldb/openetelemtry-benchmark/benchmark"
 	"github.com/ldb/openetelemtry-benchmark/config"
+	"io"
 	"net/http"
+	"strings"
+	"time"
 )
 
 var ErrInvalidName = errors.New("invalid Benchmark name")
 
 type Client struct {
 	host   string
+	token  string
 	client *http.Client
 }
 
-func NewClient(host string) Client {
-	return Client{
+// ClientOption configures a Client.
+type ClientOption func(*Client)
+
+// WithToken authenticates all requests of the Client with the bearer token.
+func WithToken(token string) ClientOption {
+	return func(c *Client) {
+		c.token = token
+	}
+}
+
+// WithTLS uses config for connections to a host with an https URL, e.g. to trust the certificate authority of benchd or to present a client certificate.
+func WithTLS(config *tls.Config) ClientOption {
+	return func(c *Client) {
+		t := http.DefaultTransport.(*http.Transport).Clone()
+		t.TLSClientConfig = config
+		c.client.Transport = t
+	}
+}
+
+func NewClient(host string, opts ...ClientOption) Client {
+	c := Client{
 		host:   host,
 		client: new(http.Client),
 	}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
+
+// ListBenchmarks returns the status of all benchmarks, ordered by name.
+func (c *Client) ListBenchmarks() ([]benchmark.Status, error) {
+	var statuses []benchmark.Status
+	if err := c.do(http.MethodGet, apiPrefix, nil, &statuses); err != nil {
+		return nil, fmt.Errorf("error listing Benchmarks: %w", err)
+	}
+	return statuses, nil
 }
 
 // CreateBenchmark creates a new benchmark with name `name`.
@@ -29,25 +68,11 @@ func (c *Client) CreateBenchmark(name string) (benchmark.Status, error) {
 	if name == "" {
 		return benchmark.Status{}, ErrInvalidName
 	}
-	url := c.host + "/create/" + name
-	r, err := http.NewRequest(http.MethodPost, url, nil)
-	if err != nil {
-		return benchmark.Status{}, fmt.Errorf("error creating request: %v", err)
-	}
-	res, err := c.client.Do(r)
-	if err != nil {
-		return benchmark.Status{}, fmt.Errorf("error performing request: %v", err)
-	}
-	defer res.Body.Close()
-	if res.StatusCode != http.StatusOK {
-		return benchmark.Status{}, fmt.Errorf("error creating Benchmark with name %s: %v", name, err)
-	}
-	status := &benchmark.Status{}
-	d := json.NewDecoder(res.Body)
-	if err := d.Decode(status); err != nil {
-		return benchmark.Status{}, fmt.Errorf("error decoding body: %v", err)
+	var status benchmark.Status
+	if err := c.do(http.MethodPost, apiPrefix, CreateRequest{Name: name}, &status); err != nil {
+		return benchmark.Status{}, fmt.Errorf("error creating Benchmark with name %s: %w", name, err)
 	}
-	return *status, nil
+	return status, nil
 }
 
 // ConfigureBenchmark configures benchmark `name` using the config.BenchConfig `config`.
@@ -55,129 +80,188 @@ func (c *Client) ConfigureBenchmark(name string, config config.BenchConfig) (ben
 	if name == "" {
 		return benchmark.Status{}, ErrInvalidName
 	}
-	url := c.host + "/configure/" + name
-	bb, err := json.Marshal(config)
-	if err != nil {
-		return benchmark.Status{}, fmt.Errorf("error encoding benchmark configuration: %v", err)
+	var status benchmark.Status
+	if err := c.do(http.MethodPut, apiPrefix+"/"+name+"/config", config, &status); err != nil {
+		return benchmark.Status{}, fmt.Errorf("error configuring Benchmark with name %s: %w", name, err)
 	}
-	r := bytes.NewReader(bb)
-	req, err := http.NewRequest(http.MethodPost, url, r)
+	return status, nil
+}
+
+func (c *Client) StartBenchmark(name string) (benchmark.Status, error) {
+	status, err := c.act(name, "start")
 	if err != nil {
-		return benchmark.Status{}, fmt.Errorf("error creating request: %v", err)
+		return benchmark.Status{}, fmt.Errorf("error starting Benchmark with name %s: %w", name, err)
 	}
-	res, err := c.client.Do(req)
+	return status, nil
+}
+
+func (c *Client) StopBenchmark(name string) (benchmark.Status, error) {
+	status, err := c.act(name, "stop")
 	if err != nil {
-		return benchmark.Status{}, fmt.Errorf("error performing request: %v", err)
-	}
-	defer res.Body.Close()
-	if res.StatusCode != http.StatusOK {
-		return benchmark.Status{}, fmt.Errorf("error configuring Benchmark with name %s: %v", name, err)
+		return benchmark.Status{}, fmt.Errorf("error stopping Benchmark with name %s: %w", name, err)
 	}
-	status := &benchmark.Status{}
-	d := json.NewDecoder(res.Body)
-	if err := d.Decode(status); err != nil {
-		return benchmark.Status{}, fmt.Errorf("error decoding body: %v", err)
-	}
-	return *status, nil
-
+	return status, nil
 }
 
-func (c *Client) StartBenchmark(name string) (benchmark.Status, error) {
-	if name == "" {
-		return benchmark.Status{}, ErrInvalidName
-	}
-	url := c.host + "/start/" + name
-	r, err := http.NewRequest(http.MethodPost, url, nil)
+// PauseBenchmark freezes all workers of benchmark `name` until it is resumed.
+func (c *Client) PauseBenchmark(name string) (benchmark.Status, error) {
+	status, err := c.act(name, "pause")
 	if err != nil {
-		return benchmark.Status{}, fmt.Errorf("error creating request: %v", err)
+		return benchmark.Status{}, fmt.Errorf("error pausing Benchmark with name %s: %w", name, err)
 	}
-	res, err := c.client.Do(r)
+	return status, nil
+}
+
+// ResumeBenchmark continues the paused benchmark `name` with the same workers.
+func (c *Client) ResumeBenchmark(name string) (benchmark.Status, error) {
+	status, err := c.act(name, "resume")
 	if err != nil {
-		return benchmark.Status{}, fmt.Errorf("error performing request: %v", err)
-	}
-	defer res.Body.Close()
-	if res.StatusCode != http.StatusOK {
-		return benchmark.Status{}, fmt.Errorf("error starting Benchmark with name %s: %v", name, err)
+		return benchmark.Status{}, fmt.Errorf("error resuming Benchmark with name %s: %w", name, err)
 	}
-	status := &benchmark.Status{}
-	d := json.NewDecoder(res.Body)
-	if err := d.Decode(status); err != nil {
-		return benchmark.Status{}, fmt.Errorf("error decoding body: %v", err)
-	}
-	return *status, nil
+	return status, nil
 }
 
-func (c *Client) StopBenchmark(name string) (benchmark.Status, error) {
+// DestroyBenchmark destroys benchmark `name` including its log file, and returns its last status.
+func (c *Client) DestroyBenchmark(name string) (benchmark.Status, error) {
 	if name == "" {
 		return benchmark.Status{}, ErrInvalidName
 	}
-	url := c.host + "/stop/" + name
-	r, err := http.NewRequest(http.MethodPost, url, nil)
-	if err != nil {
-		return benchmark.Status{}, fmt.Errorf("error creating request: %v", err)
-	}
-	res, err := c.client.Do(r)
-	if err != nil {
-		return benchmark.Status{}, fmt.Errorf("error performing request: %v", err)
+	var status benchmark.Status
+	if err := c.do(http.MethodDelete, apiPrefix+"/"+name, nil, &status); err != nil {
+		return benchmark.Status{}, fmt.Errorf("error destroying Benchmark with name %s: %w", name, err)
 	}
-	defer res.Body.Close()
-	if res.StatusCode != http.StatusOK {
-		return benchmark.Status{}, fmt.Errorf("error stopping Benchmark with name %s: %v", name, err)
+	return status, nil
+}
+
+func (c *Client) Status(name string) (benchmark.Status, error) {
+	if name == "" {
+		return benchmark.Status{}, ErrInvalidName
 	}
-	status := &benchmark.Status{}
-	d := json.NewDecoder(res.Body)
-	if err := d.Decode(status); err != nil {
-		return benchmark.Status{}, fmt.Errorf("error decoding body: %v", err)
+	var status benchmark.Status
+	if err := c.do(http.MethodGet, apiPrefix+"/"+name, nil, &status); err != nil {
+		return benchmark.Status{}, fmt.Errorf("error getting status for Benchmark with name %s: %w", name, err)
 	}
-	return *status, nil
+	return status, nil
 }
 
-func (c *Client) DestroyBenchmark(name string) (benchmark.Status, error) {
+// act performs action on benchmark `name`.
+func (c *Client) act(name, action string) (benchmark.Status, error) {
 	if name == "" {
 		return benchmark.Status{}, ErrInvalidName
 	}
-	url := c.host + "/destroy/" + name
-	r, err := http.NewRequest(http.MethodPost, url, nil)
+	var status benchmark.Status
+	if err := c.do(http.MethodPost, apiPrefix+"/"+name+"/"+action, nil, &status); err != nil {
+		return benchmark.Status{}, err
+	}
+	return status, nil
+}
+
+// do performs a request with body encoded as JSON, if it is not nil, and decodes a successful response into out.
+// If the Server responds with an Error, it is returned as *Error.
+func (c *Client) do(method, path string, body interface{}, out interface{}) error {
+	var r io.Reader
+	if body != nil {
+		bb, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("error encoding body: %v", err)
+		}
+		r = bytes.NewReader(bb)
+	}
+	req, err := http.NewRequest(method, c.host+path, r)
 	if err != nil {
-		return benchmark.Status{}, fmt.Errorf("error creating request: %v", err)
+		return fmt.Errorf("error creating request: %v", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
 	}
-	res, err := c.client.Do(r)
+	c.authenticate(req)
+	res, err := c.client.Do(req)
 	if err != nil {
-		return benchmark.Status{}, fmt.Errorf("error performing request: %v", err)
+		return fmt.Errorf("error performing request: %v", err)
 	}
 	defer res.Body.Close()
-	if res.StatusCode != http.StatusOK {
-		return benchmark.Status{}, fmt.Errorf("error destroying Benchmark with name %s: %v", name, err)
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return decodeError(res)
 	}
-	status := &benchmark.Status{}
 	d := json.NewDecoder(res.Body)
-	if err := d.Decode(status); err != nil {
-		return benchmark.Status{}, fmt.Errorf("error decoding body: %v", err)
+	if err := d.Decode(out); err != nil {
+		return fmt.Errorf("error decoding body: %v", err)
 	}
-	return *status, nil
+	return nil
 }
 
-func (c *Client) Status(name string) (benchmark.Status, error) {
+// Subscribe streams updates of benchmark `name` every interval, or every second if interval is 0.
+// The returned channel is closed once the benchmark is stopped, finished or destroyed, ctx is canceled or the connection is lost.
+// Updates are skipped while the receiver is busy, but the last update of a stopped benchmark is always delivered.
+func (c *Client) Subscribe(ctx context.Context, name string, interval time.Duration) (<-chan benchmark.Update, error) {
 	if name == "" {
-		return benchmark.Status{}, ErrInvalidName
+		return nil, ErrInvalidName
+	}
+	url := c.host + apiPrefix + "/" + name + "/events"
+	if interval > 0 {
+		url += "?interval=" + interval.String()
 	}
-	url := c.host + "/status/" + name
-	r, err := http.NewRequest(http.MethodGet, url, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
-		return benchmark.Status{}, fmt.Errorf("error creating request: %v", err)
+		return nil, fmt.Errorf("error creating request: %v", err)
 	}
-	res, err := c.client.Do(r)
+	req.Header.Set("Accept", "text/event-stream")
+	c.authenticate(req)
+	res, err := c.client.Do(req)
 	if err != nil {
-		return benchmark.Status{}, fmt.Errorf("error performing request: %v", err)
+		return nil, fmt.Errorf("error performing request: %v", err)
 	}
-	defer res.Body.Close()
 	if res.StatusCode != http.StatusOK {
-		return benchmark.Status{}, fmt.Errorf("error getting status for Benchmark with name %s: %v", name, err)
+		defer res.Body.Close()
+		return nil, fmt.Errorf("error subscribing to Benchmark with name %s: %w", name, decodeError(res))
+	}
+	updates := make(chan benchmark.Update)
+	go func() {
+		defer close(updates)
+		defer res.Body.Close()
+		s := bufio.NewScanner(res.Body)
+		var event string
+		var data []byte
+		for s.Scan() {
+			line := s.Text()
+			switch {
+			case line == "":
+				// A blank line ends an event.
+				if event == updateEvent && len(data) > 0 {
+					var u benchmark.Update
+					if err := json.Unmarshal(data, &u); err == nil {
+						select {
+						case updates <- u:
+						case <-ctx.Done():
+							return
+						}
+					}
+				}
+				event, data = "", nil
+			case strings.HasPrefix(line, "event:"):
+				event = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+			case strings.HasPrefix(line, "data:"):
+				data = append(data, strings.TrimSpace(strings.TrimPrefix(line, "data:"))...)
+			}
+		}
+	}()
+	return updates, nil
+}
+
+// authenticate adds the token of the Client to req, if it has one.
+func (c *Client) authenticate(req *http.Request) {
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
 	}
-	status := &benchmark.Status{}
+}
+
+// decodeError returns the Error in the body of an unsuccessful response.
+func decodeError(res *http.Response) *Error {
+	e := &Error{StatusCode: res.StatusCode}
 	d := json.NewDecoder(res.Body)
-	if err := d.Decode(status); err != nil {
-		return benchmark.Status{}, fmt.Errorf("error decoding body: %v", err)
+	if err := d.Decode(e); err != nil || e.Code == "" {
+		// The response does not come from the Server, e.g. from a proxy in between.
+		return &Error{StatusCode: res.StatusCode, Code: CodeInternal, Message: res.Status}
 	}
-	return *status, nil
+	return e
 }
@@ -0,0 +1,51 @@
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Codes of an Error, which tell clients what went wrong without parsing the message.
+const (
+	CodeInvalidName      = "invalidName"
+	CodeInvalidBody      = "invalidBody"
+	CodeInvalidInterval  = "invalidInterval"
+	CodeInvalidConfig    = "invalidConfig"
+	CodeNotFound         = "notFound"
+	CodeMethodNotAllowed = "methodNotAllowed"
+	CodeInvalidState     = "invalidState"
+	CodeExists           = "exists"
+	CodeLimitReached     = "limitReached"
+	CodeUnauthorized     = "unauthorized"
+	CodeForbidden        = "forbidden"
+	CodeInternal         = "internal"
+)
+
+// Error is the JSON body of every unsuccessful response of the Server.
+type Error struct {
+	// StatusCode is the HTTP status code of the response, it is not part of the body.
+	StatusCode int    `json:"-"`
+	Code       string `json:"code"`
+	Message    string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s (%d): %s", e.Code, e.StatusCode, e.Message)
+}
+
+// writeError responds to a request with an Error.
+func writeError(writer http.ResponseWriter, statusCode int, code string, message string) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(statusCode)
+	// The status code was already sent, so an error encoding the body cannot be reported anymore.
+	_ = json.NewEncoder(writer).Encode(Error{Code: code, Message: message})
+}
+
+// methodNotAllowed responds with an Error listing the allowed methods.
+func methodNotAllowed(writer http.ResponseWriter, request *http.Request, allowed ...string) {
+	for _, m := range allowed {
+		writer.Header().Add("Allow", m)
+	}
+	writeError(writer, http.StatusMethodNotAllowed, CodeMethodNotAllowed, fmt.Sprintf("method %s not allowed on %s", request.Method, request.URL.Path))
+}
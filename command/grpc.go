@@ -0,0 +1,209 @@
+package command
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"github.com/ldb/openetelemtry-benchmark/benchmark"
+	"github.com/ldb/openetelemtry-benchmark/controlpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+	"io"
+	"log"
+	"os"
+)
+
+// resultsChunkSize is the size of the chunks in which FetchResults streams a log file.
+const resultsChunkSize = 64 * 1024
+
+// controlServer implements the gRPC control service on the Benchmarks of a Server.
+type controlServer struct {
+	controlpb.UnimplementedControlServer
+	registry *registry
+}
+
+// newGRPCServer creates a gRPC server for the Benchmarks in r, which authorizes every call with auth and uses TLS if config is set.
+func newGRPCServer(r *registry, auth *authenticator, config *tls.Config) *grpc.Server {
+	opts := []grpc.ServerOption{
+		grpc.UnaryInterceptor(auth.unaryInterceptor),
+		grpc.StreamInterceptor(auth.streamInterceptor),
+	}
+	if config != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(config)))
+	}
+	s := grpc.NewServer(opts...)
+	controlpb.RegisterControlServer(s, &controlServer{registry: r})
+	return s
+}
+
+func (c *controlServer) ListBenchmarks(context.Context, *controlpb.ListBenchmarksRequest) (*controlpb.ListBenchmarksResponse, error) {
+	res := &controlpb.ListBenchmarksResponse{}
+	for _, b := range c.registry.list() {
+		res.Benchmarks = append(res.Benchmarks, controlpb.NewStatus(b.Status()))
+	}
+	return res, nil
+}
+
+func (c *controlServer) CreateBenchmark(_ context.Context, req *controlpb.BenchmarkRequest) (*controlpb.Status, error) {
+	if !validName(req.Name) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid benchmark name %q", req.Name)
+	}
+	b, err := c.registry.create(req.Name)
+	if err != nil {
+		return nil, grpcError("creating", req.Name, err)
+	}
+	log.Println("created benchmark", req.Name)
+	return controlpb.NewStatus(b.Status()), nil
+}
+
+func (c *controlServer) ConfigureBenchmark(_ context.Context, req *controlpb.ConfigureBenchmarkRequest) (*controlpb.Status, error) {
+	if !validName(req.Name) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid benchmark name %q", req.Name)
+	}
+	if req.Config == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing config")
+	}
+	cfg := req.Config.BenchConfig()
+	b := c.registry.getOrCreate(req.Name)
+	if err := b.Configure(&cfg); err != nil {
+		return nil, grpcError("configuring", req.Name, err)
+	}
+	log.Println("configured benchmark", req.Name)
+	return controlpb.NewStatus(b.Status()), nil
+}
+
+func (c *controlServer) StartBenchmark(_ context.Context, req *controlpb.BenchmarkRequest) (*controlpb.Status, error) {
+	return c.act(req.Name, "starting", "started", c.registry.start)
+}
+
+func (c *controlServer) StopBenchmark(_ context.Context, req *controlpb.BenchmarkRequest) (*controlpb.Status, error) {
+	return c.act(req.Name, "stopping", "stopped", (*benchmark.Benchmark).Stop)
+}
+
+func (c *controlServer) PauseBenchmark(_ context.Context, req *controlpb.BenchmarkRequest) (*controlpb.Status, error) {
+	return c.act(req.Name, "pausing", "paused", (*benchmark.Benchmark).Pause)
+}
+
+func (c *controlServer) ResumeBenchmark(_ context.Context, req *controlpb.BenchmarkRequest) (*controlpb.Status, error) {
+	return c.act(req.Name, "resuming", "resumed", (*benchmark.Benchmark).Resume)
+}
+
+func (c *controlServer) DestroyBenchmark(_ context.Context, req *controlpb.BenchmarkRequest) (*controlpb.Status, error) {
+	b, err := c.lookup(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := b.Destroy(); err != nil {
+		return nil, grpcError("destroying", req.Name, err)
+	}
+	c.registry.remove(b)
+	log.Println("destroyed benchmark", req.Name)
+	return controlpb.NewStatus(b.Status()), nil
+}
+
+func (c *controlServer) GetStatus(_ context.Context, req *controlpb.BenchmarkRequest) (*controlpb.Status, error) {
+	b, err := c.lookup(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	return controlpb.NewStatus(b.Status()), nil
+}
+
+func (c *controlServer) WatchStatus(req *controlpb.WatchStatusRequest, stream controlpb.Control_WatchStatusServer) error {
+	interval := defaultEventInterval
+	if req.Interval != nil {
+		interval = req.Interval.AsDuration()
+		if interval < minEventInterval {
+			return status.Errorf(codes.InvalidArgument, "interval must be at least %s", minEventInterval)
+		}
+	}
+	b, err := c.lookup(req.Name)
+	if err != nil {
+		return err
+	}
+	for u := range b.Updates(stream.Context(), interval) {
+		if err := stream.Send(controlpb.NewUpdate(u)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *controlServer) FetchResults(req *controlpb.BenchmarkRequest, stream controlpb.Control_FetchResultsServer) error {
+	b, err := c.lookup(req.Name)
+	if err != nil {
+		return err
+	}
+	logFile := b.LogFile()
+	if logFile == "" {
+		return status.Errorf(codes.FailedPrecondition, "benchmark %s has not been started", req.Name)
+	}
+	f, err := os.Open(logFile)
+	if err != nil {
+		return status.Errorf(codes.Internal, "error opening log file of benchmark %s: %v", req.Name, err)
+	}
+	defer f.Close()
+	buf := make([]byte, resultsChunkSize)
+	for {
+		n, err := f.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&controlpb.ResultsChunk{Data: buf[:n]}); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "error reading log file of benchmark %s: %v", req.Name, err)
+		}
+	}
+}
+
+// act applies action to an existing Benchmark and returns its status afterwards.
+func (c *controlServer) act(name, verb, done string, action func(*benchmark.Benchmark) error) (*controlpb.Status, error) {
+	b, err := c.lookup(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := action(b); err != nil {
+		return nil, grpcError(verb, name, err)
+	}
+	log.Println(done, "benchmark", name)
+	return controlpb.NewStatus(b.Status()), nil
+}
+
+// lookup returns the Benchmark with the given name, or a NotFound error if it does not exist.
+func (c *controlServer) lookup(name string) (*benchmark.Benchmark, error) {
+	b, ok := c.registry.get(name)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "benchmark %s does not exist", name)
+	}
+	return b, nil
+}
+
+// grpcError converts err, which occurred while doing verb to Benchmark name, to a gRPC status error.
+// It uses the same classification as writeBenchmarkError does for the HTTP API.
+func grpcError(verb, name string, err error) error {
+	message := fmt.Sprintf("error %s benchmark %s: %v", verb, name, err)
+	var configErr *benchmark.ConfigError
+	if errors.As(err, &configErr) {
+		return status.Error(codes.InvalidArgument, message)
+	}
+	var stateErr *benchmark.StateError
+	if errors.As(err, &stateErr) {
+		return status.Error(codes.FailedPrecondition, message)
+	}
+	var conflict *errConflict
+	if errors.As(err, &conflict) {
+		if conflict.code == CodeLimitReached {
+			return status.Error(codes.ResourceExhausted, message)
+		}
+		return status.Error(codes.AlreadyExists, message)
+	}
+	log.Println(message)
+	return status.Error(codes.Internal, message)
+}
@@ -0,0 +1,119 @@
+package command
+
+import (
+	"fmt"
+	"github.com/ldb/openetelemtry-benchmark/benchmark"
+	"sort"
+	"sync"
+)
+
+// errConflict is returned by the registry for requests that conflict with the Benchmarks it holds.
+type errConflict struct {
+	code    string
+	message string
+}
+
+func (e *errConflict) Error() string {
+	return e.message
+}
+
+// registry holds the Benchmarks of a Server and is safe for concurrent use.
+type registry struct {
+	mu         sync.RWMutex
+	benchmarks map[string]*benchmark.Benchmark
+	// starting serializes starting Benchmarks, so that maxRunning cannot be exceeded by concurrent starts.
+	starting sync.Mutex
+	// maxRunning is the maximum number of Benchmarks running at the same time, 0 means no limit.
+	maxRunning int
+}
+
+func newRegistry(maxRunning int) *registry {
+	return &registry{
+		benchmarks: make(map[string]*benchmark.Benchmark),
+		maxRunning: maxRunning,
+	}
+}
+
+// get returns the Benchmark with the given name.
+func (r *registry) get(name string) (*benchmark.Benchmark, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	b, ok := r.benchmarks[name]
+	return b, ok
+}
+
+// list returns all Benchmarks, ordered by name.
+func (r *registry) list() []*benchmark.Benchmark {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	names := make([]string, 0, len(r.benchmarks))
+	for name := range r.benchmarks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	bs := make([]*benchmark.Benchmark, 0, len(names))
+	for _, name := range names {
+		bs = append(bs, r.benchmarks[name])
+	}
+	return bs
+}
+
+// create creates a new Benchmark with the given name.
+// A Benchmark with the same name that was never started is replaced. One that was started is a conflict until it is destroyed,
+// so that its log file is neither lost nor left behind.
+func (r *registry) create(name string) (*benchmark.Benchmark, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if b, ok := r.benchmarks[name]; ok && b.LogFile() != "" {
+		return nil, &errConflict{code: CodeExists, message: fmt.Sprintf("benchmark %s exists and is %s, destroy it first", name, b.State())}
+	}
+	b := &benchmark.Benchmark{Name: name}
+	r.benchmarks[name] = b
+	return b, nil
+}
+
+// getOrCreate returns the Benchmark with the given name, creating it if it does not exist.
+func (r *registry) getOrCreate(name string) *benchmark.Benchmark {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	b, ok := r.benchmarks[name]
+	if !ok {
+		b = &benchmark.Benchmark{Name: name}
+		r.benchmarks[name] = b
+	}
+	return b
+}
+
+// start starts b, unless maxRunning Benchmarks are running already.
+func (r *registry) start(b *benchmark.Benchmark) error {
+	r.starting.Lock()
+	defer r.starting.Unlock()
+	if r.maxRunning > 0 {
+		if n := r.running(); n >= r.maxRunning {
+			return &errConflict{code: CodeLimitReached, message: fmt.Sprintf("%d of at most %d benchmarks running", n, r.maxRunning)}
+		}
+	}
+	return b.Start()
+}
+
+// running returns the number of Benchmarks whose Workers are running, including paused ones and finished ones that have not been stopped yet.
+func (r *registry) running() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var n int
+	for _, b := range r.benchmarks {
+		if b.State().Active() && !b.Closed() {
+			n++
+		}
+	}
+	return n
+}
+
+// remove removes b from the registry, unless its name was taken by another Benchmark in the meantime.
+func (r *registry) remove(b *benchmark.Benchmark) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.benchmarks[b.Name] == b {
+		delete(r.benchmarks, b.Name)
+	}
+}
@@ -0,0 +1,221 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ldb/openetelemtry-benchmark/benchmark"
+	"github.com/ldb/openetelemtry-benchmark/config"
+)
+
+// newTestServer serves a Server without authentication, with at most maxRunning running Benchmarks.
+func newTestServer(t *testing.T, maxRunning int) (*Server, Client) {
+	t.Helper()
+	auth, err := newAuthenticator(nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &Server{MaxRunning: maxRunning, registry: newRegistry(maxRunning)}
+	hs := httptest.NewServer(s.handler(auth))
+	t.Cleanup(hs.Close)
+	t.Cleanup(func() {
+		for _, b := range s.registry.list() {
+			_ = b.Destroy()
+		}
+	})
+	return s, NewClient(hs.URL)
+}
+
+// testConfig returns a config whose Workers send to a target that does not exist, for steps of the given duration.
+func testConfig(step time.Duration) config.BenchConfig {
+	return config.BenchConfig{
+		WorkerConfig: config.WorkerConfig{
+			Target:          "127.0.0.1:1",
+			ReceiverAddress: "127.0.0.1:0",
+			MaxTraceDepth:   2,
+			MaxSpanLength:   config.Duration{Duration: time.Millisecond},
+			MaxCoolDown:     config.Duration{Duration: 10 * time.Millisecond},
+			SendTimeout:     config.Duration{Duration: 100 * time.Millisecond},
+			ReceiveTimeout:  config.Duration{Duration: 100 * time.Millisecond},
+		},
+		Steps: []config.BenchmarkStep{{Duration: config.Duration{Duration: step}, NumberWorkers: 1}},
+	}
+}
+
+// code returns the code of an Error returned by the Client, "ok" for no error.
+func code(err error) string {
+	if err == nil {
+		return "ok"
+	}
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Code
+	}
+	return err.Error()
+}
+
+func TestConcurrentRequests(t *testing.T) {
+	s, c := newTestServer(t, 2)
+	cfg := testConfig(time.Second)
+	allowed := map[string]bool{
+		"ok":             true,
+		CodeNotFound:     true,
+		CodeInvalidState: true,
+		CodeExists:       true,
+		CodeLimitReached: true,
+	}
+	var wg sync.WaitGroup
+	errs := make(chan string, 1000)
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			name := fmt.Sprintf("b%d", g%4)
+			for i := 0; i < 16; i++ {
+				var err error
+				switch i % 8 {
+				case 0:
+					_, err = c.CreateBenchmark(name)
+				case 1:
+					_, err = c.ConfigureBenchmark(name, cfg)
+				case 2:
+					_, err = c.StartBenchmark(name)
+					if n := s.registry.running(); n > s.MaxRunning {
+						errs <- fmt.Sprintf("%d benchmarks running, at most %d allowed", n, s.MaxRunning)
+					}
+				case 3:
+					_, err = c.PauseBenchmark(name)
+				case 4:
+					_, err = c.ResumeBenchmark(name)
+				case 5:
+					_, err = c.ListBenchmarks()
+				case 6:
+					_, err = c.StopBenchmark(name)
+				case 7:
+					_, err = c.DestroyBenchmark(name)
+				}
+				if !allowed[code(err)] {
+					errs <- fmt.Sprintf("unexpected error: %v", err)
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+}
+
+func TestMaxRunning(t *testing.T) {
+	s, c := newTestServer(t, 2)
+	const n = 5
+	for i := 0; i < n; i++ {
+		if _, err := c.ConfigureBenchmark(fmt.Sprintf("b%d", i), testConfig(time.Minute)); err != nil {
+			t.Fatalf("error configuring benchmark: %v", err)
+		}
+	}
+	var wg sync.WaitGroup
+	codes := make(chan string, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, err := c.StartBenchmark(fmt.Sprintf("b%d", i))
+			codes <- code(err)
+		}(i)
+	}
+	wg.Wait()
+	close(codes)
+	counts := make(map[string]int)
+	for c := range codes {
+		counts[c]++
+	}
+	if counts["ok"] != s.MaxRunning || counts[CodeLimitReached] != n-s.MaxRunning {
+		t.Errorf("started benchmarks: got %v, want %d ok and %d %s", counts, s.MaxRunning, n-s.MaxRunning, CodeLimitReached)
+	}
+	if got := s.registry.running(); got != s.MaxRunning {
+		t.Errorf("running benchmarks: got %d, want %d", got, s.MaxRunning)
+	}
+}
+
+func TestMaxRunningCountsFinished(t *testing.T) {
+	_, c := newTestServer(t, 1)
+	// Without a duration, a Benchmark is finished once its steps are over, but its Workers keep running until it is stopped.
+	if _, err := c.ConfigureBenchmark("finished", testConfig(50*time.Millisecond)); err != nil {
+		t.Fatalf("error configuring benchmark: %v", err)
+	}
+	if _, err := c.StartBenchmark("finished"); err != nil {
+		t.Fatalf("error starting benchmark: %v", err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		st, err := c.Status("finished")
+		if err != nil {
+			t.Fatalf("error getting status: %v", err)
+		}
+		if st.State == benchmark.Finished.String() {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("benchmark did not finish, state %s", st.State)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if _, err := c.ConfigureBenchmark("next", testConfig(time.Minute)); err != nil {
+		t.Fatalf("error configuring benchmark: %v", err)
+	}
+	if _, err := c.StartBenchmark("next"); code(err) != CodeLimitReached {
+		t.Errorf("starting a benchmark next to a finished one: got %v, want %s", err, CodeLimitReached)
+	}
+	if _, err := c.StopBenchmark("finished"); err != nil {
+		t.Fatalf("error stopping benchmark: %v", err)
+	}
+	if _, err := c.StartBenchmark("next"); err != nil {
+		t.Errorf("error starting benchmark after the finished one was stopped: %v", err)
+	}
+}
+
+func TestCreateStartedBenchmark(t *testing.T) {
+	_, c := newTestServer(t, 0)
+	if _, err := c.ConfigureBenchmark("b", testConfig(time.Minute)); err != nil {
+		t.Fatalf("error configuring benchmark: %v", err)
+	}
+	// A Benchmark that was never started can be replaced.
+	if _, err := c.CreateBenchmark("b"); err != nil {
+		t.Fatalf("error replacing benchmark: %v", err)
+	}
+	if _, err := c.ConfigureBenchmark("b", testConfig(time.Minute)); err != nil {
+		t.Fatalf("error configuring benchmark: %v", err)
+	}
+	if _, err := c.StartBenchmark("b"); err != nil {
+		t.Fatalf("error starting benchmark: %v", err)
+	}
+	st, err := c.StopBenchmark("b")
+	if err != nil {
+		t.Fatalf("error stopping benchmark: %v", err)
+	}
+	if _, err := c.CreateBenchmark("b"); code(err) != CodeExists {
+		t.Errorf("creating a stopped benchmark: got %v, want %s", err, CodeExists)
+	}
+	res, err := http.Get(c.host + "/logs/" + filepath.Base(st.LogFile))
+	if err != nil {
+		t.Fatalf("error getting log file: %v", err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("log file of the stopped benchmark: got status %d, want %d", res.StatusCode, http.StatusOK)
+	}
+	if _, err := c.DestroyBenchmark("b"); err != nil {
+		t.Fatalf("error destroying benchmark: %v", err)
+	}
+	if _, err := c.CreateBenchmark("b"); err != nil {
+		t.Errorf("error creating benchmark after destroying it: %v", err)
+	}
+}
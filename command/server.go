@@ -1,26 +1,76 @@
 package command
 
 import (
+	"crypto/tls"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"github.com/ldb/openetelemtry-benchmark/benchmark"
 	"github.com/ldb/openetelemtry-benchmark/config"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
 	"log"
+	"net"
 	"net/http"
-	"os"
+	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 )
 
 const defaultHost = ":7666"
 
+// apiPrefix is the path of the collection of all Benchmarks.
+// A single Benchmark is found at apiPrefix + "/<name>", its actions below that.
+const apiPrefix = "/v1/benchmarks"
+
+const (
+	// updateEvent is the type of the server-sent events carrying a benchmark.Update.
+	updateEvent = "update"
+	// defaultEventInterval is the interval of updates if the client does not ask for one, minEventInterval the shortest it may ask for.
+	defaultEventInterval = time.Second
+	minEventInterval     = 100 * time.Millisecond
+)
+
 // Server is the main communication component for `benchctl`.
+//
+// It serves the following resources:
+//
+//	GET    /v1/benchmarks                 lists all Benchmarks
+//	POST   /v1/benchmarks                 creates a Benchmark, see CreateRequest
+//	GET    /v1/benchmarks/<name>          returns the status of a Benchmark
+//	DELETE /v1/benchmarks/<name>          destroys a Benchmark, stopping it first
+//	PUT    /v1/benchmarks/<name>/config   configures a Benchmark with a config.BenchConfig
+//	POST   /v1/benchmarks/<name>/<action> starts, stops, pauses or resumes a Benchmark
+//	GET    /v1/benchmarks/<name>/events   streams benchmark.Update as server-sent events, see Client.Subscribe
+//
+// Successful responses carry the benchmark.Status of the Benchmark, unsuccessful ones an Error.
+// The log files of the Benchmarks are served below /logs/ by their base name, and metrics for Prometheus at /metrics.
+//
+// If GRPCHost is set, the same Benchmarks can be driven through the gRPC service defined in package controlpb.
+//
+// If Tokens are set or TLSConfig verifies client certificates, clients have to authenticate to everything but /metrics.
+// Readers may only look at Benchmarks and their logs, operators may also change them.
 type Server struct {
-	Host       string
-	s          *http.Server
-	benchmarks map[string]*benchmark.Benchmark
-	init       sync.Once
+	Host     string
+	GRPCHost string
+	// MaxRunning is the maximum number of Benchmarks running at the same time, 0 means no limit.
+	MaxRunning int
+	// Tokens maps the bearer tokens that the Server accepts to the role they grant, see config.NewTokensFrom.
+	Tokens map[string]string
+	// TLSConfig enables TLS for both the HTTP API and the gRPC service.
+	// Verified client certificates grant the operator role if "operator" is one of their organizational units, and the reader role otherwise.
+	TLSConfig *tls.Config
+	s         *http.Server
+	grpc      *grpc.Server
+	registry  *registry
+	init      sync.Once
+	initErr   error
+}
+
+// CreateRequest is the body of a request to create a Benchmark.
+type CreateRequest struct {
+	Name string `json:"name"`
 }
 
 // Start starts the commandServer after initializing it exactly once.
@@ -29,212 +79,327 @@ func (c *Server) Start() error {
 		c.Host = defaultHost
 	}
 	c.init.Do(func() {
-		c.benchmarks = make(map[string]*benchmark.Benchmark)
-		mux := http.NewServeMux()
-		mux.Handle("/metrics", promhttp.Handler())
-		mux.Handle("/logs/", http.StripPrefix("/logs/", Gzip(http.FileServer(http.Dir(os.TempDir())))))
-		mux.Handle("/create/", c.createHandler())
-		mux.Handle("/configure/", c.configureHandler())
-		mux.Handle("/start/", c.startHandler())
-		mux.Handle("/stop/", c.stopHandler())
-		mux.Handle("/status/", c.statusHandler())
-		mux.Handle("/destroy/", c.destroyHandler())
-
+		auth, err := newAuthenticator(c.Tokens, verifiesClients(c.TLSConfig))
+		if err != nil {
+			c.initErr = fmt.Errorf("error reading tokens: %v", err)
+			return
+		}
+		if !auth.enabled() {
+			log.Println("warning: no tokens or client certificates configured, anyone who can reach the server controls its benchmarks")
+		}
+		c.registry = newRegistry(c.MaxRunning)
 		c.s = &http.Server{
-			Addr:    c.Host,
-			Handler: Log(mux),
+			Addr:      c.Host,
+			Handler:   Log(c.handler(auth)),
+			TLSConfig: c.TLSConfig,
 		}
+		c.grpc = newGRPCServer(c.registry, auth, c.TLSConfig)
 	})
+	if c.initErr != nil {
+		return c.initErr
+	}
+	if c.GRPCHost != "" {
+		l, err := net.Listen("tcp", c.GRPCHost)
+		if err != nil {
+			return fmt.Errorf("error listening for gRPC: %v", err)
+		}
+		log.Println("starting gRPC server on", c.GRPCHost)
+		go func() {
+			if err := c.grpc.Serve(l); err != nil {
+				log.Printf("error serving gRPC: %v", err)
+			}
+		}()
+	}
 	log.Println("starting server")
+	if c.TLSConfig != nil {
+		// The certificates are part of the TLSConfig already.
+		return c.s.ListenAndServeTLS("", "")
+	}
 	return c.s.ListenAndServe()
 }
 
-// createHandler handles HTTP requests to create a new Benchmark.
-// The last component of the HTTP Path is used as the Benchmark name.
-func (c *Server) createHandler() http.HandlerFunc {
+// handler routes the requests to the Server, authorizing them with auth.
+func (c *Server) handler(auth *authenticator) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	mux.Handle("/logs/", auth.authorize(reader, Gzip(c.logsHandler())))
+	mux.Handle(apiPrefix, auth.authorize(readOnly, c.benchmarksHandler()))
+	mux.Handle(apiPrefix+"/", auth.authorize(readOnly, c.benchmarkHandler()))
+	return mux
+}
+
+// logsHandler serves the log files of the known Benchmarks by their base name, e.g. /logs/log-benchd-plan-basic-100-123.
+// No other files can be downloaded.
+func (c *Server) logsHandler() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		if request.Method != http.MethodPost {
-			writer.WriteHeader(http.StatusNotImplemented)
-			return
+		name := strings.TrimPrefix(request.URL.Path, "/logs/")
+		for _, b := range c.registry.list() {
+			if logFile := b.LogFile(); logFile != "" && filepath.Base(logFile) == name {
+				http.ServeFile(writer, request, logFile)
+				return
+			}
 		}
-		benchmarkName, err := nameFromPath(request.URL.Path)
-		if err != nil {
-			writer.WriteHeader(http.StatusNotFound)
-			return
-		}
-		b := &benchmark.Benchmark{Name: benchmarkName}
-		c.benchmarks[benchmarkName] = b
-		status := b.Status()
-		e := json.NewEncoder(writer)
-		if err := e.Encode(status); err != nil {
-			http.Error(writer, err.Error(), http.StatusInternalServerError)
-			return
-		}
-		log.Println("created benchmark", benchmarkName)
+		writeError(writer, http.StatusNotFound, CodeNotFound, fmt.Sprintf("no log file %s", name))
 	}
 }
 
-// configureHandler handles configuring an existing Benchmark with a config.BenchConfig.
-// The last component of the HTTP Path is used as the Benchmark name.
-// It expects a JSON encoded config.BenchConfig as HTTP Body.
-// If a Benchmark with the provided name does not exist, it is transparently created.
-func (c *Server) configureHandler() http.HandlerFunc {
-	type requestBody = config.BenchConfig
+// benchmarksHandler handles listing all Benchmarks and creating new ones.
+func (c *Server) benchmarksHandler() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		if request.Method != http.MethodPost {
-			writer.WriteHeader(http.StatusNotImplemented)
-			return
-		}
-		benchmarkName, err := nameFromPath(request.URL.Path)
-		if err != nil {
-			writer.WriteHeader(http.StatusNotFound)
-			return
-		}
-		rb := new(requestBody)
-		d := json.NewDecoder(request.Body)
-		if err := d.Decode(rb); err != nil {
-			http.Error(writer, "error decoding body", http.StatusBadRequest)
-			return
-		}
-		b, ok := c.benchmarks[benchmarkName]
-		if !ok {
-			b = &benchmark.Benchmark{Name: benchmarkName}
-			c.benchmarks[benchmarkName] = b
-		}
-		b.Configure(rb)
-		status := b.Status()
-		e := json.NewEncoder(writer)
-		if err := e.Encode(status); err != nil {
-			http.Error(writer, err.Error(), http.StatusInternalServerError)
-			return
+		switch request.Method {
+		case http.MethodGet:
+			c.list(writer)
+		case http.MethodPost:
+			c.create(writer, request)
+		default:
+			methodNotAllowed(writer, request, http.MethodGet, http.MethodPost)
 		}
-		log.Println("configured benchmark", benchmarkName)
 	}
 }
 
-// startHandler handles starting an existing, configured Benchmark.
-// The last component of the HTTP Path is used as the Benchmark name.
-func (c *Server) startHandler() http.HandlerFunc {
-	return func(writer http.ResponseWriter, request *http.Request) {
-		if request.Method != http.MethodPost {
-			writer.WriteHeader(http.StatusNotImplemented)
-			return
-		}
-		benchmarkName, err := nameFromPath(request.URL.Path)
-		if err != nil {
-			writer.WriteHeader(http.StatusNotFound)
-			return
-		}
-		b, ok := c.benchmarks[benchmarkName]
-		if !ok {
-			writer.WriteHeader(http.StatusNotFound)
-			return
-		}
-		if err := b.Start(); err != nil {
-			http.Error(writer, err.Error(), http.StatusInternalServerError)
-			return
-		}
-		status := b.Status()
-		e := json.NewEncoder(writer)
-		if err := e.Encode(status); err != nil {
-			http.Error(writer, err.Error(), http.StatusInternalServerError)
-			return
-		}
-		log.Println("started benchmark", benchmarkName)
+// list responds with the status of all Benchmarks, ordered by name.
+func (c *Server) list(writer http.ResponseWriter) {
+	bs := c.registry.list()
+	statuses := make([]benchmark.Status, 0, len(bs))
+	for _, b := range bs {
+		statuses = append(statuses, b.Status())
 	}
+	writeJSON(writer, http.StatusOK, statuses)
 }
 
-// stopHandler handles stopping an existing, running Benchmark.
-// The last component of the HTTP Path is used as the Benchmark name.
-func (c *Server) stopHandler() http.HandlerFunc {
-	return func(writer http.ResponseWriter, request *http.Request) {
-		if request.Method != http.MethodPost {
-			writer.WriteHeader(http.StatusNotImplemented)
-			return
-		}
-		benchmarkName, err := nameFromPath(request.URL.Path)
-		if err != nil {
-			writer.WriteHeader(http.StatusNotFound)
-			return
-		}
-		b, ok := c.benchmarks[benchmarkName]
-		if !ok {
-			writer.WriteHeader(http.StatusNotFound)
-			return
-		}
-		if err := b.Stop(); err != nil {
-			http.Error(writer, err.Error(), http.StatusInternalServerError)
-			return
-		}
-		status := b.Status()
-		e := json.NewEncoder(writer)
-		if err := e.Encode(status); err != nil {
-			http.Error(writer, err.Error(), http.StatusInternalServerError)
-			return
-		}
-		log.Println("stopped benchmark", benchmarkName)
+// create creates a new Benchmark with the name given in a CreateRequest.
+func (c *Server) create(writer http.ResponseWriter, request *http.Request) {
+	rb := new(CreateRequest)
+	d := json.NewDecoder(request.Body)
+	if err := d.Decode(rb); err != nil {
+		writeError(writer, http.StatusBadRequest, CodeInvalidBody, fmt.Sprintf("error decoding body: %v", err))
+		return
+	}
+	if !validName(rb.Name) {
+		writeError(writer, http.StatusBadRequest, CodeInvalidName, fmt.Sprintf("invalid benchmark name %q", rb.Name))
+		return
+	}
+	b, err := c.registry.create(rb.Name)
+	if err != nil {
+		writeBenchmarkError(writer, "creating", rb.Name, err)
+		return
 	}
+	writer.Header().Set("Location", apiPrefix+"/"+rb.Name)
+	writeJSON(writer, http.StatusCreated, b.Status())
+	log.Println("created benchmark", rb.Name)
 }
 
-// statusHandler handles getting the status of an existing Benchmark.
-// The last component of the HTTP Path is used as the Benchmark name.
-func (c *Server) statusHandler() http.HandlerFunc {
+// benchmarkHandler handles requests to a single Benchmark and its actions.
+func (c *Server) benchmarkHandler() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		if request.Method != http.MethodGet {
-			writer.WriteHeader(http.StatusNotImplemented)
+		name, action := splitPath(strings.TrimPrefix(request.URL.Path, apiPrefix+"/"))
+		if !validName(name) {
+			writeError(writer, http.StatusNotFound, CodeNotFound, fmt.Sprintf("no resource at %s", request.URL.Path))
 			return
 		}
-		benchmarkName, err := nameFromPath(request.URL.Path)
-		if err != nil {
-			writer.WriteHeader(http.StatusNotFound)
-			return
-		}
-		b, ok := c.benchmarks[benchmarkName]
-		if !ok {
-			writer.WriteHeader(http.StatusNotFound)
-			return
-		}
-		status := b.Status()
-		e := json.NewEncoder(writer)
-		if err := e.Encode(status); err != nil {
-			log.Printf("error cre")
-			http.Error(writer, err.Error(), http.StatusInternalServerError)
-			return
+		switch action {
+		case "":
+			switch request.Method {
+			case http.MethodGet:
+				c.status(writer, name)
+			case http.MethodDelete:
+				c.destroy(writer, name)
+			default:
+				methodNotAllowed(writer, request, http.MethodGet, http.MethodDelete)
+			}
+		case "config":
+			if request.Method != http.MethodPut {
+				methodNotAllowed(writer, request, http.MethodPut)
+				return
+			}
+			c.configure(writer, request, name)
+		case "start", "stop", "pause", "resume":
+			if request.Method != http.MethodPost {
+				methodNotAllowed(writer, request, http.MethodPost)
+				return
+			}
+			c.act(writer, name, action)
+		case "events":
+			if request.Method != http.MethodGet {
+				methodNotAllowed(writer, request, http.MethodGet)
+				return
+			}
+			c.events(writer, request, name)
+		default:
+			writeError(writer, http.StatusNotFound, CodeNotFound, fmt.Sprintf("no resource at %s", request.URL.Path))
 		}
 	}
 }
 
-// destroyHandler handles destroying an existing Benchmark.
-// If it is not stopped, it is stopped automatically.
-// The last component of the HTTP Path is used as the Benchmark name.
-func (c *Server) destroyHandler() http.HandlerFunc {
-	return func(writer http.ResponseWriter, request *http.Request) {
-		if request.Method != http.MethodPost {
-			writer.WriteHeader(http.StatusNotImplemented)
+// lookup returns the Benchmark with the given name, or responds with an Error if it does not exist.
+func (c *Server) lookup(writer http.ResponseWriter, name string) (*benchmark.Benchmark, bool) {
+	b, ok := c.registry.get(name)
+	if !ok {
+		writeError(writer, http.StatusNotFound, CodeNotFound, fmt.Sprintf("benchmark %s does not exist", name))
+	}
+	return b, ok
+}
+
+// status responds with the status of an existing Benchmark.
+func (c *Server) status(writer http.ResponseWriter, name string) {
+	b, ok := c.lookup(writer, name)
+	if !ok {
+		return
+	}
+	writeJSON(writer, http.StatusOK, b.Status())
+}
+
+// configure configures a Benchmark with the JSON encoded config.BenchConfig in the request body.
+// If a Benchmark with the provided name does not exist, it is transparently created.
+func (c *Server) configure(writer http.ResponseWriter, request *http.Request, name string) {
+	rb := new(config.BenchConfig)
+	d := json.NewDecoder(request.Body)
+	if err := d.Decode(rb); err != nil {
+		writeError(writer, http.StatusBadRequest, CodeInvalidBody, fmt.Sprintf("error decoding body: %v", err))
+		return
+	}
+	b := c.registry.getOrCreate(name)
+	if err := b.Configure(rb); err != nil {
+		writeBenchmarkError(writer, "configuring", name, err)
+		return
+	}
+	writeJSON(writer, http.StatusOK, b.Status())
+	log.Println("configured benchmark", name)
+}
+
+// act starts, stops, pauses or resumes an existing Benchmark.
+func (c *Server) act(writer http.ResponseWriter, name, action string) {
+	b, ok := c.lookup(writer, name)
+	if !ok {
+		return
+	}
+	var err error
+	var verb, done string
+	switch action {
+	case "start":
+		err, verb, done = c.registry.start(b), "starting", "started"
+	case "stop":
+		err, verb, done = b.Stop(), "stopping", "stopped"
+	case "pause":
+		err, verb, done = b.Pause(), "pausing", "paused"
+	case "resume":
+		err, verb, done = b.Resume(), "resuming", "resumed"
+	}
+	if err != nil {
+		writeBenchmarkError(writer, verb, name, err)
+		return
+	}
+	writeJSON(writer, http.StatusOK, b.Status())
+	log.Println(done, "benchmark", name)
+}
+
+// events streams an Update of an existing Benchmark every interval as server-sent events, until it is stopped, finished or destroyed, or the client goes away.
+// The interval defaults to defaultEventInterval and can be set with the "interval" query parameter, e.g. "?interval=500ms".
+func (c *Server) events(writer http.ResponseWriter, request *http.Request, name string) {
+	interval := defaultEventInterval
+	if v := request.URL.Query().Get("interval"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < minEventInterval {
+			writeError(writer, http.StatusBadRequest, CodeInvalidInterval, fmt.Sprintf("interval must be a duration of at least %s", minEventInterval))
 			return
 		}
-		benchmarkName, err := nameFromPath(request.URL.Path)
+		interval = d
+	}
+	b, ok := c.lookup(writer, name)
+	if !ok {
+		return
+	}
+	flusher, ok := writer.(http.Flusher)
+	if !ok {
+		writeError(writer, http.StatusInternalServerError, CodeInternal, "streaming not supported")
+		return
+	}
+	writer.Header().Set("Content-Type", "text/event-stream")
+	writer.Header().Set("Cache-Control", "no-cache")
+	writer.WriteHeader(http.StatusOK)
+	flusher.Flush()
+	for u := range b.Updates(request.Context(), interval) {
+		data, err := json.Marshal(u)
 		if err != nil {
-			writer.WriteHeader(http.StatusNotFound)
+			log.Printf("error encoding update of benchmark %s: %v", name, err)
 			return
 		}
-		b, ok := c.benchmarks[benchmarkName]
-		if !ok {
-			writer.WriteHeader(http.StatusNotFound)
+		if _, err := fmt.Fprintf(writer, "event: %s\ndata: %s\n\n", updateEvent, data); err != nil {
 			return
 		}
-		if err := b.Destroy(); err != nil {
-			http.Error(writer, err.Error(), http.StatusInternalServerError)
-		}
-		delete(c.benchmarks, benchmarkName)
-		log.Println("destroyed benchmark", benchmarkName, c.benchmarks)
+		flusher.Flush()
+	}
+}
+
+// destroy destroys an existing Benchmark, stopping it first if it is running, and responds with its last status.
+func (c *Server) destroy(writer http.ResponseWriter, name string) {
+	b, ok := c.lookup(writer, name)
+	if !ok {
+		return
+	}
+	if err := b.Destroy(); err != nil {
+		writeBenchmarkError(writer, "destroying", name, err)
+		return
 	}
+	c.registry.remove(b)
+	writeJSON(writer, http.StatusOK, b.Status())
+	log.Println("destroyed benchmark", name)
 }
 
-func nameFromPath(path string) (string, error) {
-	p := strings.Split(path, "/")
-	if len(p) < 2 {
-		return "", errors.New("invalid path")
+// writeJSON responds to a request with v as JSON.
+func writeJSON(writer http.ResponseWriter, statusCode int, v interface{}) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(statusCode)
+	e := json.NewEncoder(writer)
+	if err := e.Encode(v); err != nil {
+		log.Printf("error encoding response: %v", err)
+	}
+}
+
+// writeBenchmarkError responds with an Error for err, which occurred while doing verb to Benchmark name.
+// Operations that the current state of the Benchmark does not allow are reported as conflicts, an invalid config as a bad request.
+func writeBenchmarkError(writer http.ResponseWriter, verb, name string, err error) {
+	message := fmt.Sprintf("error %s benchmark %s: %v", verb, name, err)
+	var configErr *benchmark.ConfigError
+	if errors.As(err, &configErr) {
+		writeError(writer, http.StatusBadRequest, CodeInvalidConfig, message)
+		return
+	}
+	var stateErr *benchmark.StateError
+	if errors.As(err, &stateErr) {
+		writeError(writer, http.StatusConflict, CodeInvalidState, message)
+		return
+	}
+	var conflict *errConflict
+	if errors.As(err, &conflict) {
+		writeError(writer, http.StatusConflict, conflict.code, message)
+		return
+	}
+	log.Println(message)
+	writeError(writer, http.StatusInternalServerError, CodeInternal, message)
+}
+
+// splitPath splits the path below apiPrefix into the name of a Benchmark and an optional action.
+func splitPath(path string) (name, action string) {
+	p := strings.SplitN(strings.TrimSuffix(path, "/"), "/", 2)
+	if len(p) == 2 {
+		return p[0], p[1]
+	}
+	return p[0], ""
+}
+
+// validName reports whether name can be used as the name of a Benchmark.
+// Names end up in paths and in the name of the log file, so only letters, digits, '-', '_' and '.' are allowed.
+func validName(name string) bool {
+	if name == "" || name == "." || name == ".." {
+		return false
+	}
+	for _, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_', r == '.':
+		default:
+			return false
+		}
 	}
-	return p[len(p)-1], nil
+	return true
 }
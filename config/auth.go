@@ -0,0 +1,44 @@
+package config
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Roles that a token of `benchd` can grant.
+// A reader can only look at benchmarks and download their logs, an operator can also create, control and destroy them.
+const (
+	RoleReader   = "reader"
+	RoleOperator = "operator"
+)
+
+// NewTokensFrom reads the tokens accepted by `benchd` and returns the role granted by each of them.
+// Every line holds a role and a token, separated by a space, e.g. `operator s3cr3t`.
+// Lines that start with `#` are comments, blank lines are ignored.
+func NewTokensFrom(reader io.Reader) (map[string]string, error) {
+	s := bufio.NewScanner(reader)
+	tokens := make(map[string]string)
+	for s.Scan() {
+		t := strings.TrimSpace(s.Text())
+		if t == "" || strings.HasPrefix(t, "#") {
+			continue
+		}
+		ss := strings.Split(t, " ")
+		if len(ss) != 2 || ss[1] == "" {
+			return nil, errors.New("malformed tokens")
+		}
+		switch ss[0] {
+		case RoleReader, RoleOperator:
+			tokens[ss[1]] = ss[0]
+		default:
+			return nil, fmt.Errorf("unknown role %q", ss[0])
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("error scanning tokens: %v", err)
+	}
+	return tokens, nil
+}
@@ -17,28 +17,152 @@ type BenchmarkPlan struct {
 }
 
 // BenchConfig describes the configuration for a Benchmark plan.
-// It can be used in two ways: FixedRate and Step.
+// It can be used in five ways: FixedRate, Step, ArrivalRate, Profile and Search.
 // In FixedRate mode, the benchmark will create new Workers at a constant rate until it is stopped.
 // In Step mode, a sequence of scaling steps is executed.
 // FixedRate mode can be used to quickly find a breaking point for the system under test, which can later be closely observed in Step mode.
+// Both FixedRate and Step mode are closed-loop: every Worker waits for its trace to return before sending the next one.
+// In ArrivalRate mode, traces are issued at a constant rate independent of how fast they return (open-loop).
+// This avoids coordinated omission, where the offered load drops exactly when the system under test slows down.
+// A Profile shapes the load over time, either as number of Workers (closed-loop) or as arrival rate (open-loop).
+// A Search automatically finds the highest load at which the collector stays within an SLO.
 type BenchConfig struct {
 	WorkerConfig WorkerConfig    `json:"workerConfig" yaml:"workerConfig"`
 	FixedRate    FixedRate       `json:"fixedRate" yaml:"fixedRate"`
 	Steps        []BenchmarkStep `json:"steps" yaml:"steps"`
+	ArrivalRate  ArrivalRate     `json:"arrivalRate" yaml:"arrivalRate"`
+	Profile      Profile         `json:"profile" yaml:"profile"`
+	Search       Search          `json:"search" yaml:"search"`
+	// Abort stops the Benchmark early, e.g. once the collector crashed, in any of the modes above.
+	Abort Abort `json:"abort" yaml:"abort"`
+	// Warmup and Cooldown are the durations at the start and the end of the Benchmark that are excluded from the measurement.
+	// They are marked in the log and in the "phase" label of the metrics.
+	// Cooldown requires a known end of the Benchmark, which is its Duration or Deadline, or else the end of the Steps or of the Profile.
+	Warmup   Duration `json:"warmup" yaml:"warmup"`
+	Cooldown Duration `json:"cooldown" yaml:"cooldown"`
+	// Duration and Deadline end the Benchmark after it ran for Duration or at Deadline, whichever comes first.
+	// The time the Benchmark is paused does not count towards Duration, while Deadline is a fixed point in time.
+	// benchd then stops all Workers and closes the log on its own, so that it does not depend on benchctl to stop it.
+	// If neither is set, the Benchmark runs until it is stopped.
+	Duration Duration  `json:"duration" yaml:"duration"`
+	Deadline time.Time `json:"deadline,omitempty" yaml:"deadline,omitempty"`
+	// Seed makes the generated workload repeat from run to run: every Worker makes the same random decisions for the same Seed.
+	// If it is 0, a seed is picked at random. The seed is logged in the header of the log file either way.
+	Seed int64 `json:"seed,omitempty" yaml:"seed,omitempty"`
 }
 
 type WorkerConfig struct {
-	Target          string   `json:"target" yaml:"target"`
-	ReceiverAddress string   `json:"receiverAddress" yaml:"receiverAddress"`
-	MaxTraceDepth   int      `json:"maxTraceDepth" yaml:"maxTraceDepth"`   // How deeply the generate spans should be nested.
-	MaxNumberSpans  int      `json:"maxNumberSpans" yaml:"maxNumberSpans"` // How many simultanous spans to generate per trace. // Not used yet.
-	MaxSpanLength   Duration `json:"maxSpanLength" yaml:"maxSpanLength"`
-	MaxCoolDown     Duration `json:"maxCoolDown" yaml:"maxCoolDown"` // Maximum random cooldown between requests.
-	SendTimeout     Duration `json:"sendTimeout" yaml:"sendTimeout"`
-	ReceiveTimeout  Duration `json:"receiveTimeout" yaml:"receiveTimeout"`
+	Target          string `json:"target" yaml:"target"`
+	ReceiverAddress string `json:"receiverAddress" yaml:"receiverAddress"`
+	Protocol        string `json:"protocol" yaml:"protocol"`             // Export protocol: "grpc" (default), "http/protobuf" or "http/json".
+	MaxTraceDepth   int    `json:"maxTraceDepth" yaml:"maxTraceDepth"`   // How deeply the generate spans should be nested.
+	MaxNumberSpans  int    `json:"maxNumberSpans" yaml:"maxNumberSpans"` // Upper bound of spans per trace, including the root span. Unbounded if 0.
+	// MaxFanOut is the maximum number of children of each span, by level of the trace starting with the root span.
+	// The last value applies to all deeper levels. If it is empty, every span has a single child.
+	MaxFanOut     []int    `json:"maxFanOut,omitempty" yaml:"maxFanOut,omitempty"`
+	MaxSpanLength Duration `json:"maxSpanLength" yaml:"maxSpanLength"`
+	// SyntheticTimestamps assigns explicit timestamps to spans instead of sleeping for their length while generating them.
+	// Traces are then generated as fast as possible, so that the send rate only depends on the collector.
+	SyntheticTimestamps bool     `json:"syntheticTimestamps" yaml:"syntheticTimestamps"`
+	MaxCoolDown         Duration `json:"maxCoolDown" yaml:"maxCoolDown"` // Maximum random cooldown between requests, none if it is below 1ms.
+	SendTimeout         Duration `json:"sendTimeout" yaml:"sendTimeout"`
+	ReceiveTimeout      Duration `json:"receiveTimeout" yaml:"receiveTimeout"`
 	// RiskyAttribute is a special attribute that is added to a random span in the trace for the filter based benchmarks.
 	RiskyAttributeProbability int `json:"riskyAttributeProbability" yaml:"riskyAttributeProbability"`
 	MaxExtraAttributes        int `json:"maxExtraAttributes" yaml:"maxExtraAttributes"` // The maximum number of extra attributes to add to each span.
+	// Verification describes how the collector is expected to transform each trace before returning it.
+	Verification Verification `json:"verification" yaml:"verification"`
+	// Sampling declares that the collector samples traces, so that traces which are never returned are expected.
+	Sampling Sampling `json:"sampling" yaml:"sampling"`
+	// Signals lists the signals to generate: "traces", "metrics" and "logs". Each request uses one of them at random.
+	// If it is empty, only traces are generated.
+	Signals []string `json:"signals,omitempty" yaml:"signals,omitempty"`
+	Metrics Metrics  `json:"metrics" yaml:"metrics"`
+	Logs    Logs     `json:"logs" yaml:"logs"`
+	// Compression is applied to every exported request: "gzip" or "zstd". Requests are not compressed if it is empty.
+	Compression string `json:"compression,omitempty" yaml:"compression,omitempty"`
+	// Headers are sent with every exported request, e.g. to authenticate with the collector.
+	Headers map[string]string `json:"headers,omitempty" yaml:"headers,omitempty"`
+	// TLS secures the connection to the collector, ReceiverTLS the connection on which traces are returned.
+	TLS         TLS `json:"tls" yaml:"tls"`
+	ReceiverTLS TLS `json:"receiverTLS" yaml:"receiverTLS"`
+	// Connections is the number of connections to the collector shared by all Workers.
+	// If it is 0, every Worker has its own connection, if it is 1, all Workers multiplex a single connection.
+	Connections int `json:"connections" yaml:"connections"`
+	// PayloadPool makes Workers send pre-generated traces instead of generating every trace through the tracing SDK.
+	PayloadPool PayloadPool `json:"payloadPool" yaml:"payloadPool"`
+}
+
+// PayloadPool describes a pool of traces that are generated and encoded once, before the benchmark starts.
+// Every send picks a random trace of the pool and only replaces its IDs and timestamps.
+// Unless Connections is set, all Workers share a single connection.
+// The pool is only supported with the "grpc" and "http/protobuf" protocols and only applies to traces.
+type PayloadPool struct {
+	Size int `json:"size" yaml:"size"` // Number of pre-generated traces. The pool is disabled if it is 0.
+}
+
+// TLS describes one side of a TLS connection.
+// As a client, CAFile verifies the server and CertFile and KeyFile are presented to it if set.
+// As a server, CertFile and KeyFile are required, and clients must present a certificate signed by CAFile if it is set.
+type TLS struct {
+	Enabled            bool   `json:"enabled" yaml:"enabled"`
+	CAFile             string `json:"caFile" yaml:"caFile"` // Defaults to the system roots for clients.
+	CertFile           string `json:"certFile" yaml:"certFile"`
+	KeyFile            string `json:"keyFile" yaml:"keyFile"`
+	InsecureSkipVerify bool   `json:"insecureSkipVerify" yaml:"insecureSkipVerify"` // Do not verify the server certificate, only used by clients.
+}
+
+// Sampling describes the sampling the collector is expected to apply, e.g. using the probabilistic sampler.
+// If Ratio is set, traces that are not received within the receive timeout are counted as sampled out instead of as errors.
+// In Async mode, Workers do not wait for their traces to be received before sending the next one.
+// Received and sampled out traces are still accounted for in the background, Workers wait before sending once MaxInFlight traces are outstanding.
+type Sampling struct {
+	Ratio       float64 `json:"ratio" yaml:"ratio"` // Expected ratio of traces the collector returns, between 0 and 1.
+	Async       bool    `json:"async" yaml:"async"`
+	MaxInFlight int     `json:"maxInFlight" yaml:"maxInFlight"` // Maximum number of traces awaited in the background by all Workers, defaults to 1000.
+}
+
+// Metrics configures the generation of metrics requests. Every data point carries up to MaxExtraAttributes extra attributes.
+type Metrics struct {
+	MaxDataPoints int      `json:"maxDataPoints" yaml:"maxDataPoints"` // Maximum number of data points per request.
+	Kinds         []string `json:"kinds" yaml:"kinds"`                 // Kinds of metrics to generate: "gauge", "sum" and "histogram". Defaults to all of them.
+}
+
+// Logs configures the generation of logs requests. Every log record carries up to MaxExtraAttributes extra attributes.
+type Logs struct {
+	MaxRecords int `json:"maxRecords" yaml:"maxRecords"` // Maximum number of log records per request.
+	BodySize   int `json:"bodySize" yaml:"bodySize"`     // Size of the body of each log record in bytes.
+}
+
+// Verification describes the transformation the collector is expected to apply to returned traces.
+// Every received trace is checked against it and counted as passed or failed. If it is empty, traces are not verified.
+type Verification struct {
+	AbsentAttributes        []string `json:"absentAttributes" yaml:"absentAttributes"`               // Attributes that must have been removed from every span.
+	IntactAttributePrefixes []string `json:"intactAttributePrefixes" yaml:"intactAttributePrefixes"` // Attributes with these prefixes must be returned unchanged.
+}
+
+// Enabled reports whether any verification is configured.
+func (v Verification) Enabled() bool {
+	return len(v.AbsentAttributes) > 0 || len(v.IntactAttributePrefixes) > 0
+}
+
+// Abort describes conditions under which a Benchmark stops itself before benchctl stops it.
+// Conditions are evaluated every second over the traces finished within that second. Thresholds that are zero are not checked.
+// MaxErrorRate, MaxTimeoutRatio and MaxP99 must be exceeded for at least For, so that short spikes are tolerated.
+type Abort struct {
+	MaxErrorRate    float64  `json:"maxErrorRate" yaml:"maxErrorRate"`       // Ratio of failed or dropped traces.
+	MaxTimeoutRatio float64  `json:"maxTimeoutRatio" yaml:"maxTimeoutRatio"` // Ratio of traces that were not received completely before timing out.
+	MaxP99          Duration `json:"maxP99" yaml:"maxP99"`                   // Upper bound of the 99th percentile of the receive latency.
+	For             Duration `json:"for" yaml:"for"`
+	// NothingReceivedFor aborts the Benchmark if no trace at all was received for this long.
+	NothingReceivedFor Duration `json:"nothingReceivedFor" yaml:"nothingReceivedFor"`
+	// MaxRSS is the highest resident memory of benchd in bytes, e.g. to abort before it is killed itself.
+	MaxRSS uint64 `json:"maxRSS" yaml:"maxRSS"`
+}
+
+// Enabled reports whether any abort condition is configured.
+func (a Abort) Enabled() bool {
+	return a.MaxErrorRate > 0 || a.MaxTimeoutRatio > 0 || a.MaxP99.Duration > 0 || a.NothingReceivedFor.Duration > 0 || a.MaxRSS > 0
 }
 
 // FixedRate represents scaling at a fixed rate of NumberWorkers per Duration.
@@ -47,10 +171,24 @@ type FixedRate struct {
 	Duration      Duration `json:"duration" yaml:"duration"`
 }
 
+// ArrivalRate represents open-loop load of Rate traces per second.
+// Traces that would exceed MaxInFlight outstanding traces are dropped and counted instead of being delayed.
+// If MaxInFlight is not set, it is derived from Rate and the send and receive timeouts of the WorkerConfig.
+// The traces are sent by a pool of at most Workers Workers, which await the sent traces in the background.
+// Arrivals that find all of them busy sending are dropped as well.
+type ArrivalRate struct {
+	Rate        float64 `json:"rate" yaml:"rate"`                           // Target number of traces per second.
+	Poisson     bool    `json:"poisson" yaml:"poisson"`                     // Use exponentially distributed inter-arrival times instead of a fixed interval.
+	MaxInFlight int     `json:"maxInFlight" yaml:"maxInFlight"`             // Maximum number of outstanding traces.
+	Workers     int     `json:"workers,omitempty" yaml:"workers,omitempty"` // Maximum number of Workers sending traces, 16 if not set.
+}
+
 // BenchmarkStep represents a single scaling step, that creates NumberWorkers and takes Duration to complete.
+// If TargetWorkers is set, the step scales to exactly that many Workers instead, retiring Workers if there are more.
 type BenchmarkStep struct {
 	Duration      Duration `json:"duration" yaml:"duration"`
 	NumberWorkers int      `json:"numberWorkers" yaml:"numberWorkers"`
+	TargetWorkers *int     `json:"targetWorkers,omitempty" yaml:"targetWorkers,omitempty"`
 }
 
 // Duration wraps time.Duration for it to implement json.(Un)Marshaler
@@ -14,6 +14,13 @@ type ControlConfig struct {
 	Target     string
 	Clients    []string
 	Monitoring string
+	// Token is sent to `benchd` as bearer token, see NewTokensFrom.
+	Token string
+	// CA is a PEM file with the certificate authority of `benchd`. If it is set, or Cert is, `benchd` is reached over TLS.
+	CA string
+	// Cert and Key are PEM files with a client certificate and its key, for `benchd` instances that authenticate clients by certificate.
+	Cert string
+	Key  string
 }
 
 func NewFrom(reader io.Reader) (ControlConfig, error) {
@@ -36,6 +43,14 @@ func NewFrom(reader io.Reader) (ControlConfig, error) {
 			c.Clients = append(c.Clients, ss[1])
 		case "monitoring":
 			c.Monitoring = ss[1]
+		case "token":
+			c.Token = ss[1]
+		case "ca":
+			c.CA = ss[1]
+		case "cert":
+			c.Cert = ss[1]
+		case "key":
+			c.Key = ss[1]
 		default:
 			return c, fmt.Errorf("unknown token %q", ss[0])
 		}
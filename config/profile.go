@@ -0,0 +1,101 @@
+package config
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Shapes of a Profile.
+const (
+	ShapeRamp  = "ramp"
+	ShapeSine  = "sine"
+	ShapeSpike = "spike"
+	ShapeCSV   = "csv"
+)
+
+// Profile describes a load that changes continuously over time, either as number of Workers or as open-loop arrival rate.
+// The load is interpolated and adjusted every Tick, which defaults to one second.
+// A "ramp" changes linearly from From to To over Duration.
+// A "sine" oscillates between From and To with Period, starting at From, like daily traffic does.
+// A "spike" stays at From, except for SpikeDuration starting at SpikeStart, where it jumps to To.
+// A "csv" follows Points, interpolating linearly between them. Points can be loaded from the CSV file at CSV, see ParseProfileCSV.
+// Duration defaults to the offset of the last point for "csv" profiles.
+type Profile struct {
+	Shape         string         `json:"shape" yaml:"shape"`
+	Rate          bool           `json:"rate" yaml:"rate"` // Values are traces per second instead of Workers, using ArrivalRate for all other settings.
+	Tick          Duration       `json:"tick" yaml:"tick"`
+	Duration      Duration       `json:"duration" yaml:"duration"`
+	From          float64        `json:"from" yaml:"from"`
+	To            float64        `json:"to" yaml:"to"`
+	Period        Duration       `json:"period" yaml:"period"`
+	SpikeStart    Duration       `json:"spikeStart" yaml:"spikeStart"`
+	SpikeDuration Duration       `json:"spikeDuration" yaml:"spikeDuration"`
+	CSV           string         `json:"csv,omitempty" yaml:"csv,omitempty"`
+	Points        []ProfilePoint `json:"points,omitempty" yaml:"points,omitempty"`
+}
+
+// ProfilePoint is the load Value at Offset from the start of a Profile.
+type ProfilePoint struct {
+	Offset Duration `json:"offset" yaml:"offset"`
+	Value  float64  `json:"value" yaml:"value"`
+}
+
+// ParseProfileCSV reads the points of a Profile from CSV records of offset and value, ordered by offset.
+// Offsets are either durations like "90s" or a number of seconds. Lines starting with `#` and a header line are ignored.
+func ParseProfileCSV(reader io.Reader) ([]ProfilePoint, error) {
+	r := csv.NewReader(reader)
+	r.Comment = '#'
+	r.FieldsPerRecord = 2
+	r.TrimLeadingSpace = true
+	var pp []ProfilePoint
+	for line := 1; ; line++ {
+		rec, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		p, err := parseProfilePoint(rec)
+		if err != nil {
+			if line == 1 {
+				// Probably a header.
+				continue
+			}
+			return nil, fmt.Errorf("line %d: %v", line, err)
+		}
+		if len(pp) > 0 && p.Offset.Duration < pp[len(pp)-1].Offset.Duration {
+			return nil, fmt.Errorf("line %d: offsets must be ordered", line)
+		}
+		pp = append(pp, p)
+	}
+	if len(pp) == 0 {
+		return nil, errors.New("no points found")
+	}
+	return pp, nil
+}
+
+func parseProfilePoint(rec []string) (ProfilePoint, error) {
+	var p ProfilePoint
+	offset := strings.TrimSpace(rec[0])
+	if s, err := strconv.ParseFloat(offset, 64); err == nil {
+		p.Offset = Duration{time.Duration(s * float64(time.Second))}
+	} else {
+		d, err := time.ParseDuration(offset)
+		if err != nil {
+			return p, fmt.Errorf("invalid offset %q", offset)
+		}
+		p.Offset = Duration{d}
+	}
+	v, err := strconv.ParseFloat(strings.TrimSpace(rec[1]), 64)
+	if err != nil {
+		return p, fmt.Errorf("invalid value %q", rec[1])
+	}
+	p.Value = v
+	return p, nil
+}
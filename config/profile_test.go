@@ -0,0 +1,48 @@
+package config
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseProfileCSV(t *testing.T) {
+	point := func(offset time.Duration, value float64) ProfilePoint {
+		return ProfilePoint{Offset: Duration{offset}, Value: value}
+	}
+	tests := []struct {
+		name  string
+		csv   string
+		want  []ProfilePoint
+		error bool
+	}{
+		{name: "seconds", csv: "0,1\n1.5,2\n", want: []ProfilePoint{point(0, 1), point(1500*time.Millisecond, 2)}},
+		{name: "durations", csv: "0s, 10\n1m30s, 20.5\n", want: []ProfilePoint{point(0, 10), point(90*time.Second, 20.5)}},
+		{name: "header and comments", csv: "offset,value\n# warmup\n0,1\n10s,5\n", want: []ProfilePoint{point(0, 1), point(10*time.Second, 5)}},
+		{name: "equal offsets", csv: "0,1\n5s,1\n5s,10\n", want: []ProfilePoint{point(0, 1), point(5*time.Second, 1), point(5*time.Second, 10)}},
+		{name: "empty", csv: "", error: true},
+		{name: "only header", csv: "offset,value\n", error: true},
+		{name: "unordered", csv: "10s,1\n5s,2\n", error: true},
+		{name: "invalid offset", csv: "0,1\nsoon,2\n", error: true},
+		{name: "invalid value", csv: "0,1\n10s,many\n", error: true},
+		{name: "missing value", csv: "0,1\n10s\n", error: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseProfileCSV(strings.NewReader(tt.csv))
+			if tt.error {
+				if err == nil {
+					t.Fatalf("ParseProfileCSV() = %v, want error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseProfileCSV() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseProfileCSV() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package config
+
+// Strategies of a Search.
+const (
+	SearchBinary = "binary"
+	SearchStep   = "step"
+)
+
+// Search describes an automatic search for the highest load the collector sustains within SLO.
+// Each level of load is held for Soak, after which the traces finished during it are compared against SLO.
+// The "binary" strategy halves the range between Min and Max until it is narrower than Step.
+// The "step" strategy increases the load from Min by Step until SLO is violated or Max is exceeded.
+// Levels are numbers of Workers, or traces per second if Rate is set. Step defaults to 1, Soak to one minute.
+type Search struct {
+	Strategy string   `json:"strategy" yaml:"strategy"`
+	Rate     bool     `json:"rate" yaml:"rate"` // Search the arrival rate instead of Workers, using ArrivalRate for all other settings.
+	Min      float64  `json:"min" yaml:"min"`
+	Max      float64  `json:"max" yaml:"max"`
+	Step     float64  `json:"step" yaml:"step"`
+	Soak     Duration `json:"soak" yaml:"soak"`
+	SLO      SLO      `json:"slo" yaml:"slo"`
+}
+
+// SLO holds the thresholds a level of load must stay within to be considered sustainable.
+// Thresholds that are zero are not checked. A level at which no trace was received is never sustainable.
+type SLO struct {
+	// P50, P90 and P99 are upper bounds of the respective percentiles of the receive latency.
+	P50 Duration `json:"p50" yaml:"p50"`
+	P90 Duration `json:"p90" yaml:"p90"`
+	P99 Duration `json:"p99" yaml:"p99"`
+	// MaxErrorRate is the highest acceptable ratio of failed, timed out or dropped traces.
+	MaxErrorRate float64 `json:"maxErrorRate" yaml:"maxErrorRate"`
+}
@@ -0,0 +1,213 @@
+// Package control implements a client for the gRPC control service of benchd.
+// It offers the same operations as command.Client, for tools that prefer gRPC over the HTTP API.
+package control
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/ldb/openetelemtry-benchmark/benchmark"
+	"github.com/ldb/openetelemtry-benchmark/config"
+	"github.com/ldb/openetelemtry-benchmark/controlpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var ErrInvalidName = errors.New("invalid Benchmark name")
+
+// Client drives the benchmarks of a benchd over gRPC.
+// Errors returned by benchd carry a gRPC status code, see Code.
+type Client struct {
+	conn   *grpc.ClientConn
+	client controlpb.ControlClient
+}
+
+// Code returns the gRPC status code of an error returned by a Client, codes.Unknown if it has none, or codes.OK if err is nil.
+func Code(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	var s interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &s) {
+		return s.GRPCStatus().Code()
+	}
+	return codes.Unknown
+}
+
+// Dial connects to the gRPC control service of benchd at target, e.g. "localhost:7667".
+// Without any opts, the connection is not encrypted. Otherwise opts have to configure the transport security,
+// e.g. with grpc.WithTransportCredentials, or grpc.WithInsecure in addition to WithToken.
+func Dial(target string, opts ...grpc.DialOption) (*Client, error) {
+	if len(opts) == 0 {
+		opts = []grpc.DialOption{grpc.WithInsecure()}
+	}
+	conn, err := grpc.Dial(target, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to %s: %v", target, err)
+	}
+	return &Client{conn: conn, client: controlpb.NewControlClient(conn)}, nil
+}
+
+// WithToken authenticates all calls with the bearer token.
+func WithToken(token string) grpc.DialOption {
+	return grpc.WithPerRPCCredentials(tokenCredentials(token))
+}
+
+// tokenCredentials sends a bearer token with every call.
+// Like the HTTP API, it allows connections without TLS, whose tokens can be read by anyone in between.
+type tokenCredentials string
+
+func (t tokenCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+func (t tokenCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+// Close closes the connection to benchd.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// ListBenchmarks returns the status of all benchmarks, ordered by name.
+func (c *Client) ListBenchmarks(ctx context.Context) ([]benchmark.Status, error) {
+	res, err := c.client.ListBenchmarks(ctx, &controlpb.ListBenchmarksRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing Benchmarks: %w", err)
+	}
+	statuses := make([]benchmark.Status, 0, len(res.Benchmarks))
+	for _, s := range res.Benchmarks {
+		statuses = append(statuses, s.BenchmarkStatus())
+	}
+	return statuses, nil
+}
+
+// CreateBenchmark creates a new benchmark with name `name`.
+func (c *Client) CreateBenchmark(ctx context.Context, name string) (benchmark.Status, error) {
+	if name == "" {
+		return benchmark.Status{}, ErrInvalidName
+	}
+	s, err := c.client.CreateBenchmark(ctx, &controlpb.BenchmarkRequest{Name: name})
+	if err != nil {
+		return benchmark.Status{}, fmt.Errorf("error creating Benchmark with name %s: %w", name, err)
+	}
+	return s.BenchmarkStatus(), nil
+}
+
+// ConfigureBenchmark configures benchmark `name` using the config.BenchConfig `config`.
+func (c *Client) ConfigureBenchmark(ctx context.Context, name string, config config.BenchConfig) (benchmark.Status, error) {
+	if name == "" {
+		return benchmark.Status{}, ErrInvalidName
+	}
+	s, err := c.client.ConfigureBenchmark(ctx, &controlpb.ConfigureBenchmarkRequest{Name: name, Config: controlpb.NewBenchConfig(config)})
+	if err != nil {
+		return benchmark.Status{}, fmt.Errorf("error configuring Benchmark with name %s: %w", name, err)
+	}
+	return s.BenchmarkStatus(), nil
+}
+
+func (c *Client) StartBenchmark(ctx context.Context, name string) (benchmark.Status, error) {
+	return c.act(ctx, name, "starting", c.client.StartBenchmark)
+}
+
+func (c *Client) StopBenchmark(ctx context.Context, name string) (benchmark.Status, error) {
+	return c.act(ctx, name, "stopping", c.client.StopBenchmark)
+}
+
+// PauseBenchmark freezes all workers of benchmark `name` until it is resumed.
+func (c *Client) PauseBenchmark(ctx context.Context, name string) (benchmark.Status, error) {
+	return c.act(ctx, name, "pausing", c.client.PauseBenchmark)
+}
+
+// ResumeBenchmark continues the paused benchmark `name` with the same workers.
+func (c *Client) ResumeBenchmark(ctx context.Context, name string) (benchmark.Status, error) {
+	return c.act(ctx, name, "resuming", c.client.ResumeBenchmark)
+}
+
+// DestroyBenchmark destroys benchmark `name` including its log file, and returns its last status.
+func (c *Client) DestroyBenchmark(ctx context.Context, name string) (benchmark.Status, error) {
+	return c.act(ctx, name, "destroying", c.client.DestroyBenchmark)
+}
+
+func (c *Client) Status(ctx context.Context, name string) (benchmark.Status, error) {
+	return c.act(ctx, name, "getting status for", c.client.GetStatus)
+}
+
+// Subscribe streams updates of benchmark `name` every interval, or every second if interval is 0.
+// The returned channel is closed once the benchmark is stopped, finished or destroyed, ctx is canceled or the connection is lost.
+func (c *Client) Subscribe(ctx context.Context, name string, interval time.Duration) (<-chan benchmark.Update, error) {
+	if name == "" {
+		return nil, ErrInvalidName
+	}
+	req := &controlpb.WatchStatusRequest{Name: name}
+	if interval > 0 {
+		req.Interval = durationpb.New(interval)
+	}
+	stream, err := c.client.WatchStatus(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("error subscribing to Benchmark with name %s: %w", name, err)
+	}
+	// Errors such as an unknown benchmark are only reported with the first message of the stream.
+	first, err := stream.Recv()
+	if err != nil {
+		return nil, fmt.Errorf("error subscribing to Benchmark with name %s: %w", name, err)
+	}
+	updates := make(chan benchmark.Update)
+	go func() {
+		defer close(updates)
+		u := first
+		for {
+			select {
+			case updates <- u.BenchmarkUpdate():
+			case <-ctx.Done():
+				return
+			}
+			var err error
+			if u, err = stream.Recv(); err != nil {
+				return
+			}
+		}
+	}()
+	return updates, nil
+}
+
+// FetchResults writes the log file of benchmark `name` to w.
+func (c *Client) FetchResults(ctx context.Context, name string, w io.Writer) error {
+	if name == "" {
+		return ErrInvalidName
+	}
+	stream, err := c.client.FetchResults(ctx, &controlpb.BenchmarkRequest{Name: name})
+	if err != nil {
+		return fmt.Errorf("error fetching results of Benchmark with name %s: %w", name, err)
+	}
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("error fetching results of Benchmark with name %s: %w", name, err)
+		}
+		if _, err := w.Write(chunk.Data); err != nil {
+			return fmt.Errorf("error writing results: %v", err)
+		}
+	}
+}
+
+// act calls rpc for benchmark `name`, verb describes the call in errors.
+func (c *Client) act(ctx context.Context, name, verb string, rpc func(context.Context, *controlpb.BenchmarkRequest, ...grpc.CallOption) (*controlpb.Status, error)) (benchmark.Status, error) {
+	if name == "" {
+		return benchmark.Status{}, ErrInvalidName
+	}
+	s, err := rpc(ctx, &controlpb.BenchmarkRequest{Name: name})
+	if err != nil {
+		return benchmark.Status{}, fmt.Errorf("error %s Benchmark with name %s: %w", verb, name, err)
+	}
+	return s.BenchmarkStatus(), nil
+}
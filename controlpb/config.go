@@ -0,0 +1,267 @@
+package controlpb
+
+import (
+	"github.com/ldb/openetelemtry-benchmark/config"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// NewBenchConfig converts c to a BenchConfig message.
+func NewBenchConfig(c config.BenchConfig) *BenchConfig {
+	bc := &BenchConfig{
+		WorkerConfig: newWorkerConfig(c.WorkerConfig),
+		FixedRate: &FixedRate{
+			NumberWorkers: int64(c.FixedRate.NumberWorkers),
+			Duration:      newDuration(c.FixedRate.Duration),
+		},
+		ArrivalRate: &ArrivalRate{
+			Rate:        c.ArrivalRate.Rate,
+			Poisson:     c.ArrivalRate.Poisson,
+			MaxInFlight: int64(c.ArrivalRate.MaxInFlight),
+			Workers:     int64(c.ArrivalRate.Workers),
+		},
+		Profile: &Profile{
+			Shape:         c.Profile.Shape,
+			Rate:          c.Profile.Rate,
+			Tick:          newDuration(c.Profile.Tick),
+			Duration:      newDuration(c.Profile.Duration),
+			From:          c.Profile.From,
+			To:            c.Profile.To,
+			Period:        newDuration(c.Profile.Period),
+			SpikeStart:    newDuration(c.Profile.SpikeStart),
+			SpikeDuration: newDuration(c.Profile.SpikeDuration),
+			Csv:           c.Profile.CSV,
+		},
+		Search: &Search{
+			Strategy: c.Search.Strategy,
+			Rate:     c.Search.Rate,
+			Min:      c.Search.Min,
+			Max:      c.Search.Max,
+			Step:     c.Search.Step,
+			Soak:     newDuration(c.Search.Soak),
+			Slo: &SLO{
+				P50:          newDuration(c.Search.SLO.P50),
+				P90:          newDuration(c.Search.SLO.P90),
+				P99:          newDuration(c.Search.SLO.P99),
+				MaxErrorRate: c.Search.SLO.MaxErrorRate,
+			},
+		},
+		Abort: &Abort{
+			MaxErrorRate:       c.Abort.MaxErrorRate,
+			MaxTimeoutRatio:    c.Abort.MaxTimeoutRatio,
+			MaxP99:             newDuration(c.Abort.MaxP99),
+			For:                newDuration(c.Abort.For),
+			NothingReceivedFor: newDuration(c.Abort.NothingReceivedFor),
+			MaxRss:             c.Abort.MaxRSS,
+		},
+		Warmup:   newDuration(c.Warmup),
+		Cooldown: newDuration(c.Cooldown),
+		Duration: newDuration(c.Duration),
+		Seed:     c.Seed,
+	}
+	for _, s := range c.Steps {
+		step := &BenchmarkStep{Duration: newDuration(s.Duration), NumberWorkers: int64(s.NumberWorkers)}
+		if s.TargetWorkers != nil {
+			t := int64(*s.TargetWorkers)
+			step.TargetWorkers = &t
+		}
+		bc.Steps = append(bc.Steps, step)
+	}
+	for _, p := range c.Profile.Points {
+		bc.Profile.Points = append(bc.Profile.Points, &ProfilePoint{Offset: newDuration(p.Offset), Value: p.Value})
+	}
+	if !c.Deadline.IsZero() {
+		bc.Deadline = timestamppb.New(c.Deadline)
+	}
+	return bc
+}
+
+func newWorkerConfig(c config.WorkerConfig) *WorkerConfig {
+	wc := &WorkerConfig{
+		Target:                    c.Target,
+		ReceiverAddress:           c.ReceiverAddress,
+		Protocol:                  c.Protocol,
+		MaxTraceDepth:             int64(c.MaxTraceDepth),
+		MaxNumberSpans:            int64(c.MaxNumberSpans),
+		MaxSpanLength:             newDuration(c.MaxSpanLength),
+		SyntheticTimestamps:       c.SyntheticTimestamps,
+		MaxCoolDown:               newDuration(c.MaxCoolDown),
+		SendTimeout:               newDuration(c.SendTimeout),
+		ReceiveTimeout:            newDuration(c.ReceiveTimeout),
+		RiskyAttributeProbability: int64(c.RiskyAttributeProbability),
+		MaxExtraAttributes:        int64(c.MaxExtraAttributes),
+		Verification: &Verification{
+			AbsentAttributes:        c.Verification.AbsentAttributes,
+			IntactAttributePrefixes: c.Verification.IntactAttributePrefixes,
+		},
+		Sampling: &Sampling{Ratio: c.Sampling.Ratio, Async: c.Sampling.Async, MaxInFlight: int64(c.Sampling.MaxInFlight)},
+		Signals:  c.Signals,
+		Metrics: &Metrics{
+			MaxDataPoints: int64(c.Metrics.MaxDataPoints),
+			Kinds:         c.Metrics.Kinds,
+		},
+		Logs: &Logs{
+			MaxRecords: int64(c.Logs.MaxRecords),
+			BodySize:   int64(c.Logs.BodySize),
+		},
+		Compression: c.Compression,
+		Headers:     c.Headers,
+		Tls:         newTLS(c.TLS),
+		ReceiverTls: newTLS(c.ReceiverTLS),
+		Connections: int64(c.Connections),
+		PayloadPool: &PayloadPool{Size: int64(c.PayloadPool.Size)},
+	}
+	for _, n := range c.MaxFanOut {
+		wc.MaxFanOut = append(wc.MaxFanOut, int64(n))
+	}
+	return wc
+}
+
+func newTLS(t config.TLS) *TLS {
+	return &TLS{
+		Enabled:            t.Enabled,
+		CaFile:             t.CAFile,
+		CertFile:           t.CertFile,
+		KeyFile:            t.KeyFile,
+		InsecureSkipVerify: t.InsecureSkipVerify,
+	}
+}
+
+// BenchConfig converts c back to a config.BenchConfig. Messages that are not set result in zero values.
+func (c *BenchConfig) BenchConfig() config.BenchConfig {
+	f, a, p, s, ab := c.GetFixedRate(), c.GetArrivalRate(), c.GetProfile(), c.GetSearch(), c.GetAbort()
+	bc := config.BenchConfig{
+		WorkerConfig: c.GetWorkerConfig().WorkerConfig(),
+		FixedRate: config.FixedRate{
+			NumberWorkers: int(f.GetNumberWorkers()),
+			Duration:      configDuration(f.GetDuration()),
+		},
+		ArrivalRate: config.ArrivalRate{
+			Rate:        a.GetRate(),
+			Poisson:     a.GetPoisson(),
+			MaxInFlight: int(a.GetMaxInFlight()),
+			Workers:     int(a.GetWorkers()),
+		},
+		Profile: config.Profile{
+			Shape:         p.GetShape(),
+			Rate:          p.GetRate(),
+			Tick:          configDuration(p.GetTick()),
+			Duration:      configDuration(p.GetDuration()),
+			From:          p.GetFrom(),
+			To:            p.GetTo(),
+			Period:        configDuration(p.GetPeriod()),
+			SpikeStart:    configDuration(p.GetSpikeStart()),
+			SpikeDuration: configDuration(p.GetSpikeDuration()),
+			CSV:           p.GetCsv(),
+		},
+		Search: config.Search{
+			Strategy: s.GetStrategy(),
+			Rate:     s.GetRate(),
+			Min:      s.GetMin(),
+			Max:      s.GetMax(),
+			Step:     s.GetStep(),
+			Soak:     configDuration(s.GetSoak()),
+			SLO: config.SLO{
+				P50:          configDuration(s.GetSlo().GetP50()),
+				P90:          configDuration(s.GetSlo().GetP90()),
+				P99:          configDuration(s.GetSlo().GetP99()),
+				MaxErrorRate: s.GetSlo().GetMaxErrorRate(),
+			},
+		},
+		Abort: config.Abort{
+			MaxErrorRate:       ab.GetMaxErrorRate(),
+			MaxTimeoutRatio:    ab.GetMaxTimeoutRatio(),
+			MaxP99:             configDuration(ab.GetMaxP99()),
+			For:                configDuration(ab.GetFor()),
+			NothingReceivedFor: configDuration(ab.GetNothingReceivedFor()),
+			MaxRSS:             ab.GetMaxRss(),
+		},
+		Warmup:   configDuration(c.GetWarmup()),
+		Cooldown: configDuration(c.GetCooldown()),
+		Duration: configDuration(c.GetDuration()),
+		Seed:     c.GetSeed(),
+	}
+	for _, s := range c.GetSteps() {
+		step := config.BenchmarkStep{Duration: configDuration(s.GetDuration()), NumberWorkers: int(s.GetNumberWorkers())}
+		if s.TargetWorkers != nil {
+			t := int(s.GetTargetWorkers())
+			step.TargetWorkers = &t
+		}
+		bc.Steps = append(bc.Steps, step)
+	}
+	for _, pp := range p.GetPoints() {
+		bc.Profile.Points = append(bc.Profile.Points, config.ProfilePoint{Offset: configDuration(pp.GetOffset()), Value: pp.GetValue()})
+	}
+	if d := c.GetDeadline(); d != nil {
+		bc.Deadline = d.AsTime()
+	}
+	return bc
+}
+
+// WorkerConfig converts c back to a config.WorkerConfig.
+func (c *WorkerConfig) WorkerConfig() config.WorkerConfig {
+	wc := config.WorkerConfig{
+		Target:                    c.GetTarget(),
+		ReceiverAddress:           c.GetReceiverAddress(),
+		Protocol:                  c.GetProtocol(),
+		MaxTraceDepth:             int(c.GetMaxTraceDepth()),
+		MaxNumberSpans:            int(c.GetMaxNumberSpans()),
+		MaxSpanLength:             configDuration(c.GetMaxSpanLength()),
+		SyntheticTimestamps:       c.GetSyntheticTimestamps(),
+		MaxCoolDown:               configDuration(c.GetMaxCoolDown()),
+		SendTimeout:               configDuration(c.GetSendTimeout()),
+		ReceiveTimeout:            configDuration(c.GetReceiveTimeout()),
+		RiskyAttributeProbability: int(c.GetRiskyAttributeProbability()),
+		MaxExtraAttributes:        int(c.GetMaxExtraAttributes()),
+		Verification: config.Verification{
+			AbsentAttributes:        c.GetVerification().GetAbsentAttributes(),
+			IntactAttributePrefixes: c.GetVerification().GetIntactAttributePrefixes(),
+		},
+		Sampling: config.Sampling{
+			Ratio:       c.GetSampling().GetRatio(),
+			Async:       c.GetSampling().GetAsync(),
+			MaxInFlight: int(c.GetSampling().GetMaxInFlight()),
+		},
+		Signals: c.GetSignals(),
+		Metrics: config.Metrics{
+			MaxDataPoints: int(c.GetMetrics().GetMaxDataPoints()),
+			Kinds:         c.GetMetrics().GetKinds(),
+		},
+		Logs: config.Logs{
+			MaxRecords: int(c.GetLogs().GetMaxRecords()),
+			BodySize:   int(c.GetLogs().GetBodySize()),
+		},
+		Compression: c.GetCompression(),
+		Headers:     c.GetHeaders(),
+		TLS:         c.GetTls().TLS(),
+		ReceiverTLS: c.GetReceiverTls().TLS(),
+		Connections: int(c.GetConnections()),
+		PayloadPool: config.PayloadPool{Size: int(c.GetPayloadPool().GetSize())},
+	}
+	for _, n := range c.GetMaxFanOut() {
+		wc.MaxFanOut = append(wc.MaxFanOut, int(n))
+	}
+	return wc
+}
+
+// TLS converts t back to a config.TLS.
+func (t *TLS) TLS() config.TLS {
+	return config.TLS{
+		Enabled:            t.GetEnabled(),
+		CAFile:             t.GetCaFile(),
+		CertFile:           t.GetCertFile(),
+		KeyFile:            t.GetKeyFile(),
+		InsecureSkipVerify: t.GetInsecureSkipVerify(),
+	}
+}
+
+func newDuration(d config.Duration) *durationpb.Duration {
+	if d.Duration == 0 {
+		return nil
+	}
+	return durationpb.New(d.Duration)
+}
+
+func configDuration(d *durationpb.Duration) config.Duration {
+	return config.Duration{Duration: duration(d)}
+}
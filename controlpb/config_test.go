@@ -0,0 +1,104 @@
+package controlpb
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ldb/openetelemtry-benchmark/config"
+	"google.golang.org/protobuf/proto"
+)
+
+func d(s string) config.Duration {
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		panic(err)
+	}
+	return config.Duration{Duration: v}
+}
+
+func TestBenchConfigRoundTrip(t *testing.T) {
+	target := 4
+	tls := config.TLS{Enabled: true, CAFile: "ca.pem", CertFile: "cert.pem", KeyFile: "key.pem", InsecureSkipVerify: true}
+	c := config.BenchConfig{
+		WorkerConfig: config.WorkerConfig{
+			Target:                    "collector:4317",
+			ReceiverAddress:           ":2113",
+			Protocol:                  "http/protobuf",
+			MaxTraceDepth:             3,
+			MaxNumberSpans:            20,
+			MaxFanOut:                 []int{3, 2, 1},
+			MaxSpanLength:             d("10ms"),
+			SyntheticTimestamps:       true,
+			MaxCoolDown:               d("50ms"),
+			SendTimeout:               d("1s"),
+			ReceiveTimeout:            d("5s"),
+			RiskyAttributeProbability: 10,
+			MaxExtraAttributes:        5,
+			Verification:              config.Verification{AbsentAttributes: []string{"risky"}, IntactAttributePrefixes: []string{"keep."}},
+			Sampling:                  config.Sampling{Ratio: 0.5, Async: true, MaxInFlight: 100},
+			Signals:                   []string{"traces", "metrics", "logs"},
+			Metrics:                   config.Metrics{MaxDataPoints: 7, Kinds: []string{"gauge"}},
+			Logs:                      config.Logs{MaxRecords: 8, BodySize: 128},
+			Compression:               "gzip",
+			Headers:                   map[string]string{"x-token": "t"},
+			TLS:                       tls,
+			ReceiverTLS:               tls,
+			Connections:               2,
+			PayloadPool:               config.PayloadPool{Size: 100},
+		},
+		FixedRate:   config.FixedRate{NumberWorkers: 2, Duration: d("10s")},
+		Steps:       []config.BenchmarkStep{{Duration: d("1m"), NumberWorkers: 2}, {Duration: d("2m"), TargetWorkers: &target}},
+		ArrivalRate: config.ArrivalRate{Rate: 100, Poisson: true, MaxInFlight: 50, Workers: 8},
+		Profile: config.Profile{
+			Shape:         config.ShapeCSV,
+			Rate:          true,
+			Tick:          d("2s"),
+			Duration:      d("10m"),
+			From:          1,
+			To:            10,
+			Period:        d("5m"),
+			SpikeStart:    d("1m"),
+			SpikeDuration: d("30s"),
+			CSV:           "profile.csv",
+			Points:        []config.ProfilePoint{{Offset: d("0s"), Value: 1}, {Offset: d("1m"), Value: 2}},
+		},
+		Search: config.Search{
+			Strategy: config.SearchBinary,
+			Rate:     true,
+			Min:      10,
+			Max:      1000,
+			Step:     10,
+			Soak:     d("30s"),
+			SLO:      config.SLO{P50: d("10ms"), P90: d("50ms"), P99: d("100ms"), MaxErrorRate: 0.01},
+		},
+		Abort: config.Abort{
+			MaxErrorRate:       0.1,
+			MaxTimeoutRatio:    0.2,
+			MaxP99:             d("1s"),
+			For:                d("10s"),
+			NothingReceivedFor: d("30s"),
+			MaxRSS:             1 << 30,
+		},
+		Warmup:   d("1m"),
+		Cooldown: d("30s"),
+		Duration: d("10m"),
+		Deadline: time.Date(2030, 1, 2, 3, 4, 5, 6, time.UTC),
+		Seed:     42,
+	}
+	b, err := proto.Marshal(NewBenchConfig(c))
+	if err != nil {
+		t.Fatalf("error encoding config: %v", err)
+	}
+	m := new(BenchConfig)
+	if err := proto.Unmarshal(b, m); err != nil {
+		t.Fatalf("error decoding config: %v", err)
+	}
+	if got := m.BenchConfig(); !reflect.DeepEqual(got, c) {
+		t.Errorf("config changed in round trip:\ngot  %+v\nwant %+v", got, c)
+	}
+
+	if got := NewBenchConfig(config.BenchConfig{}).BenchConfig(); !reflect.DeepEqual(got, config.BenchConfig{}) {
+		t.Errorf("empty config changed in round trip: %+v", got)
+	}
+}
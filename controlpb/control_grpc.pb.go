@@ -0,0 +1,530 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package controlpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ControlClient is the client API for Control service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ControlClient interface {
+	// ListBenchmarks returns the status of all benchmarks, ordered by name.
+	ListBenchmarks(ctx context.Context, in *ListBenchmarksRequest, opts ...grpc.CallOption) (*ListBenchmarksResponse, error)
+	// CreateBenchmark creates a new benchmark.
+	CreateBenchmark(ctx context.Context, in *BenchmarkRequest, opts ...grpc.CallOption) (*Status, error)
+	// ConfigureBenchmark configures a benchmark that has not been started yet, creating it if it does not exist.
+	ConfigureBenchmark(ctx context.Context, in *ConfigureBenchmarkRequest, opts ...grpc.CallOption) (*Status, error)
+	StartBenchmark(ctx context.Context, in *BenchmarkRequest, opts ...grpc.CallOption) (*Status, error)
+	StopBenchmark(ctx context.Context, in *BenchmarkRequest, opts ...grpc.CallOption) (*Status, error)
+	// PauseBenchmark freezes all workers of a running benchmark until it is resumed.
+	PauseBenchmark(ctx context.Context, in *BenchmarkRequest, opts ...grpc.CallOption) (*Status, error)
+	ResumeBenchmark(ctx context.Context, in *BenchmarkRequest, opts ...grpc.CallOption) (*Status, error)
+	// DestroyBenchmark stops a benchmark if it is running and deletes it including its log file.
+	DestroyBenchmark(ctx context.Context, in *BenchmarkRequest, opts ...grpc.CallOption) (*Status, error)
+	GetStatus(ctx context.Context, in *BenchmarkRequest, opts ...grpc.CallOption) (*Status, error)
+	// WatchStatus streams an update of a benchmark every interval, until it is stopped, finished or destroyed.
+	WatchStatus(ctx context.Context, in *WatchStatusRequest, opts ...grpc.CallOption) (Control_WatchStatusClient, error)
+	// FetchResults streams the log file of a benchmark in chunks.
+	FetchResults(ctx context.Context, in *BenchmarkRequest, opts ...grpc.CallOption) (Control_FetchResultsClient, error)
+}
+
+type controlClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewControlClient(cc grpc.ClientConnInterface) ControlClient {
+	return &controlClient{cc}
+}
+
+func (c *controlClient) ListBenchmarks(ctx context.Context, in *ListBenchmarksRequest, opts ...grpc.CallOption) (*ListBenchmarksResponse, error) {
+	out := new(ListBenchmarksResponse)
+	err := c.cc.Invoke(ctx, "/benchd.control.v1.Control/ListBenchmarks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) CreateBenchmark(ctx context.Context, in *BenchmarkRequest, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/benchd.control.v1.Control/CreateBenchmark", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) ConfigureBenchmark(ctx context.Context, in *ConfigureBenchmarkRequest, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/benchd.control.v1.Control/ConfigureBenchmark", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) StartBenchmark(ctx context.Context, in *BenchmarkRequest, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/benchd.control.v1.Control/StartBenchmark", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) StopBenchmark(ctx context.Context, in *BenchmarkRequest, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/benchd.control.v1.Control/StopBenchmark", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) PauseBenchmark(ctx context.Context, in *BenchmarkRequest, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/benchd.control.v1.Control/PauseBenchmark", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) ResumeBenchmark(ctx context.Context, in *BenchmarkRequest, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/benchd.control.v1.Control/ResumeBenchmark", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) DestroyBenchmark(ctx context.Context, in *BenchmarkRequest, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/benchd.control.v1.Control/DestroyBenchmark", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) GetStatus(ctx context.Context, in *BenchmarkRequest, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/benchd.control.v1.Control/GetStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) WatchStatus(ctx context.Context, in *WatchStatusRequest, opts ...grpc.CallOption) (Control_WatchStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Control_ServiceDesc.Streams[0], "/benchd.control.v1.Control/WatchStatus", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &controlWatchStatusClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Control_WatchStatusClient interface {
+	Recv() (*Update, error)
+	grpc.ClientStream
+}
+
+type controlWatchStatusClient struct {
+	grpc.ClientStream
+}
+
+func (x *controlWatchStatusClient) Recv() (*Update, error) {
+	m := new(Update)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *controlClient) FetchResults(ctx context.Context, in *BenchmarkRequest, opts ...grpc.CallOption) (Control_FetchResultsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Control_ServiceDesc.Streams[1], "/benchd.control.v1.Control/FetchResults", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &controlFetchResultsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Control_FetchResultsClient interface {
+	Recv() (*ResultsChunk, error)
+	grpc.ClientStream
+}
+
+type controlFetchResultsClient struct {
+	grpc.ClientStream
+}
+
+func (x *controlFetchResultsClient) Recv() (*ResultsChunk, error) {
+	m := new(ResultsChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ControlServer is the server API for Control service.
+// All implementations must embed UnimplementedControlServer
+// for forward compatibility
+type ControlServer interface {
+	// ListBenchmarks returns the status of all benchmarks, ordered by name.
+	ListBenchmarks(context.Context, *ListBenchmarksRequest) (*ListBenchmarksResponse, error)
+	// CreateBenchmark creates a new benchmark.
+	CreateBenchmark(context.Context, *BenchmarkRequest) (*Status, error)
+	// ConfigureBenchmark configures a benchmark that has not been started yet, creating it if it does not exist.
+	ConfigureBenchmark(context.Context, *ConfigureBenchmarkRequest) (*Status, error)
+	StartBenchmark(context.Context, *BenchmarkRequest) (*Status, error)
+	StopBenchmark(context.Context, *BenchmarkRequest) (*Status, error)
+	// PauseBenchmark freezes all workers of a running benchmark until it is resumed.
+	PauseBenchmark(context.Context, *BenchmarkRequest) (*Status, error)
+	ResumeBenchmark(context.Context, *BenchmarkRequest) (*Status, error)
+	// DestroyBenchmark stops a benchmark if it is running and deletes it including its log file.
+	DestroyBenchmark(context.Context, *BenchmarkRequest) (*Status, error)
+	GetStatus(context.Context, *BenchmarkRequest) (*Status, error)
+	// WatchStatus streams an update of a benchmark every interval, until it is stopped, finished or destroyed.
+	WatchStatus(*WatchStatusRequest, Control_WatchStatusServer) error
+	// FetchResults streams the log file of a benchmark in chunks.
+	FetchResults(*BenchmarkRequest, Control_FetchResultsServer) error
+	mustEmbedUnimplementedControlServer()
+}
+
+// UnimplementedControlServer must be embedded to have forward compatible implementations.
+type UnimplementedControlServer struct {
+}
+
+func (UnimplementedControlServer) ListBenchmarks(context.Context, *ListBenchmarksRequest) (*ListBenchmarksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBenchmarks not implemented")
+}
+func (UnimplementedControlServer) CreateBenchmark(context.Context, *BenchmarkRequest) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBenchmark not implemented")
+}
+func (UnimplementedControlServer) ConfigureBenchmark(context.Context, *ConfigureBenchmarkRequest) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfigureBenchmark not implemented")
+}
+func (UnimplementedControlServer) StartBenchmark(context.Context, *BenchmarkRequest) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartBenchmark not implemented")
+}
+func (UnimplementedControlServer) StopBenchmark(context.Context, *BenchmarkRequest) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopBenchmark not implemented")
+}
+func (UnimplementedControlServer) PauseBenchmark(context.Context, *BenchmarkRequest) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseBenchmark not implemented")
+}
+func (UnimplementedControlServer) ResumeBenchmark(context.Context, *BenchmarkRequest) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeBenchmark not implemented")
+}
+func (UnimplementedControlServer) DestroyBenchmark(context.Context, *BenchmarkRequest) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DestroyBenchmark not implemented")
+}
+func (UnimplementedControlServer) GetStatus(context.Context, *BenchmarkRequest) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatus not implemented")
+}
+func (UnimplementedControlServer) WatchStatus(*WatchStatusRequest, Control_WatchStatusServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchStatus not implemented")
+}
+func (UnimplementedControlServer) FetchResults(*BenchmarkRequest, Control_FetchResultsServer) error {
+	return status.Errorf(codes.Unimplemented, "method FetchResults not implemented")
+}
+func (UnimplementedControlServer) mustEmbedUnimplementedControlServer() {}
+
+// UnsafeControlServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ControlServer will
+// result in compilation errors.
+type UnsafeControlServer interface {
+	mustEmbedUnimplementedControlServer()
+}
+
+func RegisterControlServer(s grpc.ServiceRegistrar, srv ControlServer) {
+	s.RegisterService(&Control_ServiceDesc, srv)
+}
+
+func _Control_ListBenchmarks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBenchmarksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).ListBenchmarks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/benchd.control.v1.Control/ListBenchmarks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).ListBenchmarks(ctx, req.(*ListBenchmarksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_CreateBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BenchmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).CreateBenchmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/benchd.control.v1.Control/CreateBenchmark",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).CreateBenchmark(ctx, req.(*BenchmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_ConfigureBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigureBenchmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).ConfigureBenchmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/benchd.control.v1.Control/ConfigureBenchmark",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).ConfigureBenchmark(ctx, req.(*ConfigureBenchmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_StartBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BenchmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).StartBenchmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/benchd.control.v1.Control/StartBenchmark",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).StartBenchmark(ctx, req.(*BenchmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_StopBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BenchmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).StopBenchmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/benchd.control.v1.Control/StopBenchmark",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).StopBenchmark(ctx, req.(*BenchmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_PauseBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BenchmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).PauseBenchmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/benchd.control.v1.Control/PauseBenchmark",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).PauseBenchmark(ctx, req.(*BenchmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_ResumeBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BenchmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).ResumeBenchmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/benchd.control.v1.Control/ResumeBenchmark",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).ResumeBenchmark(ctx, req.(*BenchmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_DestroyBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BenchmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).DestroyBenchmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/benchd.control.v1.Control/DestroyBenchmark",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).DestroyBenchmark(ctx, req.(*BenchmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BenchmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).GetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/benchd.control.v1.Control/GetStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).GetStatus(ctx, req.(*BenchmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_WatchStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchStatusRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ControlServer).WatchStatus(m, &controlWatchStatusServer{stream})
+}
+
+type Control_WatchStatusServer interface {
+	Send(*Update) error
+	grpc.ServerStream
+}
+
+type controlWatchStatusServer struct {
+	grpc.ServerStream
+}
+
+func (x *controlWatchStatusServer) Send(m *Update) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Control_FetchResults_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BenchmarkRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ControlServer).FetchResults(m, &controlFetchResultsServer{stream})
+}
+
+type Control_FetchResultsServer interface {
+	Send(*ResultsChunk) error
+	grpc.ServerStream
+}
+
+type controlFetchResultsServer struct {
+	grpc.ServerStream
+}
+
+func (x *controlFetchResultsServer) Send(m *ResultsChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Control_ServiceDesc is the grpc.ServiceDesc for Control service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Control_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "benchd.control.v1.Control",
+	HandlerType: (*ControlServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListBenchmarks",
+			Handler:    _Control_ListBenchmarks_Handler,
+		},
+		{
+			MethodName: "CreateBenchmark",
+			Handler:    _Control_CreateBenchmark_Handler,
+		},
+		{
+			MethodName: "ConfigureBenchmark",
+			Handler:    _Control_ConfigureBenchmark_Handler,
+		},
+		{
+			MethodName: "StartBenchmark",
+			Handler:    _Control_StartBenchmark_Handler,
+		},
+		{
+			MethodName: "StopBenchmark",
+			Handler:    _Control_StopBenchmark_Handler,
+		},
+		{
+			MethodName: "PauseBenchmark",
+			Handler:    _Control_PauseBenchmark_Handler,
+		},
+		{
+			MethodName: "ResumeBenchmark",
+			Handler:    _Control_ResumeBenchmark_Handler,
+		},
+		{
+			MethodName: "DestroyBenchmark",
+			Handler:    _Control_DestroyBenchmark_Handler,
+		},
+		{
+			MethodName: "GetStatus",
+			Handler:    _Control_GetStatus_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchStatus",
+			Handler:       _Control_WatchStatus_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "FetchResults",
+			Handler:       _Control_FetchResults_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "control.proto",
+}
@@ -0,0 +1,160 @@
+// Package controlpb holds the protobuf definition of the gRPC control service of benchd and the code generated from it.
+// It also converts between its messages and the types of the benchmark package.
+package controlpb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative control.proto
+
+import (
+	"time"
+
+	"github.com/ldb/openetelemtry-benchmark/benchmark"
+	"github.com/ldb/openetelemtry-benchmark/worker"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// NewStatus converts s to a Status message.
+func NewStatus(s benchmark.Status) *Status {
+	m := s.ManagerState
+	st := &Status{
+		Name:        s.Name,
+		State:       State(benchmark.StateFrom(s.State)),
+		CurrentStep: int64(s.CurrentStep),
+		MaxStep:     int64(s.MaxStep),
+		ManagerState: &ManagerStatus{
+			ActiveWorkers:         int64(m.ActiveWorkers),
+			Errors:                int64(m.Errors),
+			InFlight:              int64(m.InFlight),
+			DroppedArrivals:       int64(m.DroppedArrivals),
+			CompleteTraces:        int64(m.CompleteTraces),
+			PartialTraces:         int64(m.PartialTraces),
+			Duplicates:            int64(m.Duplicates),
+			LostTraces:            int64(m.LostTraces),
+			LateArrivals:          int64(m.LateArrivals),
+			UnknownTraces:         int64(m.UnknownTraces),
+			VerificationsPassed:   int64(m.VerificationsPassed),
+			VerificationsFailed:   int64(m.VerificationsFailed),
+			SamplingRatio:         m.SamplingRatio,
+			ObservedSamplingRatio: m.ObservedSamplingRatio,
+		},
+		LogFile: s.LogFile,
+		Target:  s.Target,
+		Aborted: s.Aborted,
+		Phase:   s.Phase,
+		Seed:    s.Seed,
+	}
+	if s.Search != nil {
+		st.Search = &SearchResult{Level: s.Search.Level, Capacity: s.Search.Capacity, Levels: int64(s.Search.Levels), Done: s.Search.Done}
+	}
+	if s.Measurement != nil {
+		st.Measurement = NewWindow(*s.Measurement)
+	}
+	if s.End != nil {
+		st.End = timestamppb.New(*s.End)
+	}
+	return st
+}
+
+// BenchmarkStatus converts s back to a benchmark.Status.
+func (s *Status) BenchmarkStatus() benchmark.Status {
+	m := s.GetManagerState()
+	st := benchmark.Status{
+		Name:        s.GetName(),
+		State:       benchmark.State(s.GetState()).String(),
+		CurrentStep: int(s.GetCurrentStep()),
+		MaxStep:     int(s.GetMaxStep()),
+		ManagerState: worker.Status{
+			ActiveWorkers:         int(m.GetActiveWorkers()),
+			Errors:                int(m.GetErrors()),
+			InFlight:              int(m.GetInFlight()),
+			DroppedArrivals:       int(m.GetDroppedArrivals()),
+			CompleteTraces:        int(m.GetCompleteTraces()),
+			PartialTraces:         int(m.GetPartialTraces()),
+			Duplicates:            int(m.GetDuplicates()),
+			LostTraces:            int(m.GetLostTraces()),
+			LateArrivals:          int(m.GetLateArrivals()),
+			UnknownTraces:         int(m.GetUnknownTraces()),
+			VerificationsPassed:   int(m.GetVerificationsPassed()),
+			VerificationsFailed:   int(m.GetVerificationsFailed()),
+			SamplingRatio:         m.GetSamplingRatio(),
+			ObservedSamplingRatio: m.GetObservedSamplingRatio(),
+		},
+		LogFile: s.GetLogFile(),
+		Target:  s.GetTarget(),
+		Aborted: s.GetAborted(),
+		Phase:   s.GetPhase(),
+		Seed:    s.GetSeed(),
+	}
+	if r := s.GetSearch(); r != nil {
+		st.Search = &benchmark.SearchResult{Level: r.Level, Capacity: r.Capacity, Levels: int(r.Levels), Done: r.Done}
+	}
+	if w := s.GetMeasurement(); w != nil {
+		m := w.Window()
+		st.Measurement = &m
+	}
+	if e := s.GetEnd(); e != nil {
+		end := e.AsTime()
+		st.End = &end
+	}
+	return st
+}
+
+// NewWindow converts w to a Window message.
+func NewWindow(w worker.Window) *Window {
+	return &Window{
+		Duration:   durationpb.New(w.Duration),
+		Sent:       int64(w.Sent),
+		Errors:     int64(w.Errors),
+		Received:   int64(w.Received),
+		Timeouts:   int64(w.Timeouts),
+		Dropped:    int64(w.Dropped),
+		SendRate:   w.SendRate,
+		Throughput: w.Throughput,
+		P50:        durationpb.New(w.P50),
+		P90:        durationpb.New(w.P90),
+		P99:        durationpb.New(w.P99),
+	}
+}
+
+// Window converts w back to a worker.Window. Its percentiles cannot be recomputed for other quantiles.
+func (w *Window) Window() worker.Window {
+	return worker.Window{
+		Duration:   duration(w.GetDuration()),
+		Sent:       int(w.GetSent()),
+		Errors:     int(w.GetErrors()),
+		Received:   int(w.GetReceived()),
+		Timeouts:   int(w.GetTimeouts()),
+		Dropped:    int(w.GetDropped()),
+		SendRate:   w.GetSendRate(),
+		Throughput: w.GetThroughput(),
+		P50:        duration(w.GetP50()),
+		P90:        duration(w.GetP90()),
+		P99:        duration(w.GetP99()),
+	}
+}
+
+// NewUpdate converts u to an Update message.
+func NewUpdate(u benchmark.Update) *Update {
+	up := &Update{Status: NewStatus(u.Status)}
+	if u.Window != nil {
+		up.Window = NewWindow(*u.Window)
+	}
+	return up
+}
+
+// BenchmarkUpdate converts u back to a benchmark.Update.
+func (u *Update) BenchmarkUpdate() benchmark.Update {
+	up := benchmark.Update{Status: u.GetStatus().BenchmarkStatus()}
+	if w := u.GetWindow(); w != nil {
+		window := w.Window()
+		up.Window = &window
+	}
+	return up
+}
+
+func duration(d *durationpb.Duration) time.Duration {
+	if d == nil {
+		return 0
+	}
+	return d.AsDuration()
+}
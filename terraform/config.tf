@@ -7,6 +7,7 @@ monitoring ${google_compute_instance.monitoring.network_interface.0.access_confi
 %{for ip in google_compute_instance.clients.*.network_interface.0.access_config.0.nat_ip~}
 client ${ip}
 %{endfor~}
+token ${random_password.benchd_token.result}
 EOT
 }
 
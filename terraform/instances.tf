@@ -48,6 +48,12 @@ resource "google_compute_instance" "otel-collector" {
   }
 }
 
+# Token that `benchctl` uses to control the benchmarks on the clients.
+resource "random_password" "benchd_token" {
+  length  = 32
+  special = false
+}
+
 resource "google_compute_instance" "clients" {
   count = var.number_clients
 
@@ -82,12 +88,17 @@ resource "google_compute_instance" "clients" {
     source      = "${path.module}/../bin/benchd"
     destination = "benchd"
   }
+  provisioner "file" {
+    content     = "operator ${random_password.benchd_token.result}\n"
+    destination = "benchd.tokens"
+  }
   provisioner "remote-exec" {
     on_failure = continue
     inline = [
       #"sudo base64 --decode benchdb64 > benchd",
       "sudo chmod +x benchd",
       "sudo cp benchd /usr/local/bin/benchd",
+      "sudo install -m 600 benchd.tokens /etc/benchd.tokens",
       "sleep 1",
       "sudo systemctl start benchd",
     ]
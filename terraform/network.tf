@@ -4,6 +4,7 @@ data "google_compute_network" "benchmark_vpc" {
 
 # We make sure that only we can actually send requests to the benchmarking client.
 # Traffic is not encrypted, but hey, at least its something :)
+# `benchd` additionally requires the token from benchctl.config for everything but its metrics.
 data "http" "own_ip" {
   url = "http://ipv4.icanhazip.com"
 }
@@ -18,7 +19,7 @@ resource "google_compute_firewall" "default" {
 
   allow {
     protocol = "tcp"
-    ports    = ["7666", "9090"]
+    ports    = ["7666", "7667", "9090"]
   }
 
   source_ranges = [local.own_ip]
@@ -4,6 +4,10 @@ terraform {
       source  = "hashicorp/google"
       version = "4.5.0"
     }
+    random = {
+      source  = "hashicorp/random"
+      version = "3.1.0"
+    }
   }
 }
 
@@ -9,7 +9,7 @@ Description=benchd Benchmarking Client
 After=network.target
 
 [Service]
-ExecStart=/usr/local/bin/benchd
+ExecStart=/usr/local/bin/benchd -tokens /etc/benchd.tokens
 KillMode=mixed
 Restart=on-failure
 Type=simple
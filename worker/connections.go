@@ -0,0 +1,129 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/ldb/openetelemtry-benchmark/config"
+	"google.golang.org/grpc"
+)
+
+// Connection models: every Worker has its own connection, Workers share a fixed pool of connections, or all share one.
+const (
+	ConnectionsPerWorker = "per-worker"
+	ConnectionsPool      = "pool"
+	ConnectionsShared    = "shared"
+)
+
+// connectionModel returns the connection model described by the number of connections to the collector.
+func connectionModel(n int) string {
+	switch {
+	case n <= 0:
+		return ConnectionsPerWorker
+	case n == 1:
+		return ConnectionsShared
+	default:
+		return ConnectionsPool
+	}
+}
+
+// connPool is a fixed number of connections to the collector, which are assigned to Workers in turn.
+// Over HTTP, every connection is a client that keeps at most a single connection open.
+type connPool struct {
+	conns []*grpc.ClientConn
+	https []*httpClient
+}
+
+func newConnPool(c config.WorkerConfig, n int) (*connPool, error) {
+	p := &connPool{}
+	for i := 0; i < n; i++ {
+		conn, hc, err := dial(c)
+		if err != nil {
+			p.close()
+			return nil, err
+		}
+		if hc != nil {
+			hc.client.Transport.(*http.Transport).MaxConnsPerHost = 1
+		}
+		p.conns = append(p.conns, conn)
+		p.https = append(p.https, hc)
+	}
+	return p, nil
+}
+
+// assign hands the i-th connection of the pool, wrapping around, to w.
+func (p *connPool) assign(w *Worker, i int) {
+	w.conn = p.conns[i%len(p.conns)]
+	w.http = p.https[i%len(p.https)]
+	w.sharedConn = true
+}
+
+func (p *connPool) len() int {
+	return len(p.conns)
+}
+
+func (p *connPool) close() {
+	for i := range p.conns {
+		closeConn(p.conns[i], p.https[i])
+	}
+}
+
+// dial connects to c.Target with the protocol configured in c.
+// Connections are established lazily, so errors connecting to the collector surface when sending.
+func dial(c config.WorkerConfig) (*grpc.ClientConn, *httpClient, error) {
+	switch p := protocol(c); p {
+	case ProtocolGRPC:
+		opts, err := dialOptions(c)
+		if err != nil {
+			return nil, nil, err
+		}
+		conn, err := grpc.Dial(c.Target, opts...)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error connecting to %s: %v", c.Target, err)
+		}
+		return conn, nil, nil
+	case ProtocolHTTPProtobuf, ProtocolHTTPJSON:
+		tlsCfg, err := tlsConfig(c.TLS, false)
+		if err != nil {
+			return nil, nil, err
+		}
+		return nil, newHTTPClient(c.Target, c, tlsCfg), nil
+	default:
+		return nil, nil, fmt.Errorf("unknown protocol %q", p)
+	}
+}
+
+func closeConn(conn *grpc.ClientConn, hc *httpClient) {
+	if conn != nil {
+		conn.Close()
+	}
+	if hc != nil {
+		hc.client.CloseIdleConnections()
+	}
+}
+
+// connect connects the Worker to the collector, unless the Manager assigned it a shared connection.
+func (w *Worker) connect() error {
+	if w.conn != nil || w.http != nil {
+		return nil
+	}
+	var err error
+	w.conn, w.http, err = dial(w.Config)
+	return err
+}
+
+// close shuts down the tracing SDK of the Worker, exporting the spans it still holds until ctx is done,
+// and closes the connection of the Worker, unless it is shared with other Workers.
+func (w *Worker) close(ctx context.Context) {
+	if w.tracerProvider != nil {
+		if err := w.tracerProvider.Shutdown(ctx); err != nil && w.Logger != nil {
+			w.Logger.Printf("error shutting down tracer provider: %v", err)
+		}
+	}
+	if w.sharedConn {
+		return
+	}
+	closeConn(w.conn, w.http)
+	exporterConnections.WithLabelValues(w.managerName, ConnectionsPerWorker).Dec()
+}
@@ -0,0 +1,179 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/ldb/openetelemtry-benchmark/config"
+	"go.opentelemetry.io/collector/model/otlpgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/protobuf/proto"
+)
+
+// Protocols a Worker can use to export signals to the collector.
+const (
+	ProtocolGRPC         = "grpc"
+	ProtocolHTTPProtobuf = "http/protobuf"
+	ProtocolHTTPJSON     = "http/json"
+)
+
+// protocol returns the export protocol configured in c, which defaults to ProtocolGRPC.
+func protocol(c config.WorkerConfig) string {
+	if c.Protocol == "" {
+		return ProtocolGRPC
+	}
+	return c.Protocol
+}
+
+// newTraceClient creates the client used by the tracing SDK to export traces over the connection of the Worker.
+func (w *Worker) newTraceClient() otlptrace.Client {
+	if w.http != nil {
+		return &httpTraceClient{w.http}
+	}
+	// TLS and compression are already configured on the connection, see dialOptions.
+	return otlptracegrpc.NewClient(
+		otlptracegrpc.WithGRPCConn(w.conn),
+		otlptracegrpc.WithHeaders(w.Config.Headers),
+	)
+}
+
+// dialOptions returns the options used to connect to the collector over gRPC.
+func dialOptions(c config.WorkerConfig) ([]grpc.DialOption, error) {
+	tlsCfg, err := tlsConfig(c.TLS, false)
+	if err != nil {
+		return nil, err
+	}
+	var opts []grpc.DialOption
+	if tlsCfg != nil {
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsCfg)))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+	if c.Compression != "" {
+		opts = append(opts, grpc.WithDefaultCallOptions(grpc.UseCompressor(c.Compression)))
+	}
+	return opts, nil
+}
+
+// otlpRequest is implemented by all request types of the otlpgrpc package.
+type otlpRequest interface {
+	Marshal() ([]byte, error)
+	MarshalJSON() ([]byte, error)
+}
+
+// httpClient exports OTLP requests over HTTP, encoded either as protobuf or as JSON.
+// Requests are sent to the `/v1/traces`, `/v1/metrics` and `/v1/logs` paths of the collector's OTLP/HTTP receiver.
+type httpClient struct {
+	endpoint    string
+	json        bool
+	compression string
+	headers     map[string]string
+	client      *http.Client
+}
+
+// newHTTPClient creates an httpClient for target. It uses HTTPS if tlsCfg is not nil.
+func newHTTPClient(target string, c config.WorkerConfig, tlsCfg *tls.Config) *httpClient {
+	scheme := "http://"
+	if tlsCfg != nil {
+		scheme = "https://"
+	}
+	return &httpClient{
+		endpoint:    scheme + target,
+		json:        protocol(c) == ProtocolHTTPJSON,
+		compression: c.Compression,
+		headers:     c.Headers,
+		client:      &http.Client{Transport: &http.Transport{TLSClientConfig: tlsCfg}},
+	}
+}
+
+// export encodes req and posts it to path.
+func (c *httpClient) export(ctx context.Context, path string, req otlpRequest) error {
+	var b []byte
+	var err error
+	if c.json {
+		b, err = req.MarshalJSON()
+	} else {
+		b, err = req.Marshal()
+	}
+	if err != nil {
+		return fmt.Errorf("error encoding request: %v", err)
+	}
+	return c.post(ctx, path, b)
+}
+
+// post sends an already encoded request body to path.
+func (c *httpClient) post(ctx context.Context, path string, body []byte) error {
+	if c.compression != "" {
+		var err error
+		if body, err = compress(c.compression, body); err != nil {
+			return fmt.Errorf("error compressing request: %v", err)
+		}
+	}
+	r, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	if c.json {
+		r.Header.Set("Content-Type", "application/json")
+	} else {
+		r.Header.Set("Content-Type", "application/x-protobuf")
+	}
+	if c.compression != "" {
+		r.Header.Set("Content-Encoding", c.compression)
+	}
+	for k, v := range c.headers {
+		r.Header.Set(k, v)
+	}
+	resp, err := c.client.Do(r)
+	if err != nil {
+		return err
+	}
+	// Drain the body, so that the connection can be reused.
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected response status %q", resp.Status)
+	}
+	return nil
+}
+
+// httpTraceClient adapts an httpClient to be used as exporter by the tracing SDK.
+type httpTraceClient struct {
+	*httpClient
+}
+
+func (c *httpTraceClient) Start(context.Context) error {
+	return nil
+}
+
+func (c *httpTraceClient) Stop(context.Context) error {
+	c.client.CloseIdleConnections()
+	return nil
+}
+
+// UploadTraces sends the spans exported by the SDK.
+// For JSON, they are converted to the collector's own representation, so that they are encoded the way its receiver expects.
+func (c *httpTraceClient) UploadTraces(ctx context.Context, protoSpans []*tracepb.ResourceSpans) error {
+	b, err := proto.Marshal(&coltracepb.ExportTraceServiceRequest{ResourceSpans: protoSpans})
+	if err != nil {
+		return fmt.Errorf("error encoding request: %v", err)
+	}
+	if !c.json {
+		return c.post(ctx, "/v1/traces", b)
+	}
+	req, err := otlpgrpc.UnmarshalTracesRequest(b)
+	if err != nil {
+		return fmt.Errorf("error converting request: %v", err)
+	}
+	return c.export(ctx, "/v1/traces", req)
+}
@@ -3,25 +3,41 @@ package worker
 import (
 	"context"
 	"errors"
+	"fmt"
 	"github.com/ldb/openetelemtry-benchmark/config"
 	"io"
 	"log"
+	"math"
+	"math/rand"
 	"sync"
 	"time"
+
+	"go.opentelemetry.io/otel/trace"
 )
 
 var ErrWorkerManagerStopped = errors.New("manager statusStopped")
 
+// defaultArrivalWorkers is the size of the pool of Workers sending arrivals, unless the config sets one.
+const defaultArrivalWorkers = 16
+
+// defaultAsyncInFlight is the most traces Workers await in the background in Async mode, unless the config sets it.
+const defaultAsyncInFlight = 1000
+
+// closeTimeout bounds how long a Worker that is retired or stopped may take to export the spans it still holds.
+const closeTimeout = 5 * time.Second
+
 // Manager manages a number of workers based on a config.WorkerConfig. New workers can be added during runtime.
-// Workers cannot be removed during runtime. Once the manager is statusStopped, all workers are statusStopped.
+// Workers can be retired during runtime, they stop once their current trace is finished. Once the manager is statusStopped, all workers are statusStopped.
 // A statusStopped Manager can not be reused.
 // Managers can be named. Their name reflects in collected worker metrics.
 type Manager struct {
 	name     string
 	nWorkers int
-	ctx      context.Context
-	cancel   context.CancelFunc
-	config   config.WorkerConfig
+	// nextID is the ID of the next Worker, IDs of retired Workers are not reused.
+	nextID int
+	ctx    context.Context
+	cancel context.CancelFunc
+	config config.WorkerConfig
 	// workers tracks active Workers.
 	workers []*Worker
 	// newWorkers is a list of newly added Workers that are not yet statusInitialized.
@@ -34,6 +50,37 @@ type Manager struct {
 	errors    int
 	mu        sync.RWMutex
 	logWriter io.Writer
+	// idle holds Workers that are waiting for their next trace in open-loop mode, arrivalWorkers is the most Workers there are.
+	idle           chan *Worker
+	arrivalWorkers int
+	// arrivals tracks the Workers issuing arrivals, they are kept apart from workers so that SetWorkers does not retire them.
+	arrivals    []*Worker
+	nArrivals   int
+	maxInFlight int
+	// rate is the current number of arrivals per second, it can be changed at runtime.
+	rate        float64
+	rateChanged chan struct{}
+	inFlight    int
+	// slots limits the traces Workers await in the background in Async mode, it is nil otherwise.
+	slots chan struct{}
+	// dropped counts arrivals that were not sent because maxInFlight traces were already outstanding or all Workers were busy sending.
+	dropped int
+	// registry tracks all traces in flight by their trace ID.
+	registry *registry
+	// latencies counts the roundtrip durations of all received traces, see Snapshot.
+	latencies *latencies
+	// phase is the current phase of the benchmark, see SetPhase.
+	phase *phase
+	// pauser holds all Workers while the Manager is paused.
+	pauser *pauser
+	// seed determines all random decisions of the Workers, see SetSeed.
+	seed int64
+	// rand schedules Poisson distributed arrivals.
+	rand *rand.Rand
+	// pool holds pre-generated traces if config.PayloadPool is enabled.
+	pool *payloadPool
+	// conns holds the connections shared by all Workers, nil if every Worker has its own.
+	conns *connPool
 }
 
 // NewManager creates a new Manager based on a config.WorkerConfig.
@@ -46,31 +93,123 @@ func NewManager(name string, writer io.Writer) *Manager {
 	m.workers = make([]*Worker, 0)
 	m.newWorkers = make([]*Worker, 0)
 	m.logWriter = writer
+	m.registry = newRegistry(name, defaultFinishedCapacity)
+	m.latencies = &latencies{}
+	m.phase = &phase{}
+	m.pauser = newPauser()
+	m.registry.phase = m.phase
 
 	m.logger = log.New(writer, "M "+name+" ", log.Ltime|log.Lmicroseconds|log.LUTC)
 
 	return m
 }
 
-func (m *Manager) Configure(config config.WorkerConfig) {
+// Configure sets the config of all Workers of the Manager. It returns an error if they cannot run with it, see ValidateConfig.
+func (m *Manager) Configure(config config.WorkerConfig) error {
+	if err := ValidateConfig(config); err != nil {
+		return err
+	}
 	m.mu.Lock()
 	defer m.mu.Unlock()
 	m.config = config
-	m.receiver = &receiver{Host: m.config.ReceiverAddress}
+	m.receiver = &receiver{
+		Host:           m.config.ReceiverAddress,
+		TLS:            m.config.ReceiverTLS,
+		Verifiers:      verifiersFrom(m.config.Verification),
+		IntactPrefixes: m.config.Verification.IntactAttributePrefixes,
+	}
+	m.registry.verify = m.config.Verification.Enabled()
+	m.registry.onVerificationFailure = func(id trace.TraceID, err error) {
+		m.logger.Printf("VerificationFailed %s %v", id, err)
+	}
+	if m.config.PayloadPool.Size > 0 {
+		pool, err := newPayloadPool(m.name, m.config, newRand(m.seed, seedIDPayloadPool))
+		if err != nil {
+			return fmt.Errorf("error creating payload pool: %v", err)
+		}
+		m.pool = pool
+	}
+	if m.config.Sampling.Async {
+		n := m.config.Sampling.MaxInFlight
+		if n <= 0 {
+			n = defaultAsyncInFlight
+		}
+		m.slots = make(chan struct{}, n)
+	}
+	n := m.config.Connections
+	if m.pool != nil && n <= 0 {
+		// Sending pre-generated traces is cheap, so a single connection goes a long way.
+		n = 1
+	}
+	if n > 0 {
+		conns, err := newConnPool(m.config, n)
+		if err != nil {
+			return fmt.Errorf("error creating connection pool: %v", err)
+		}
+		m.conns = conns
+		exporterConnections.WithLabelValues(m.name, m.connectionModel()).Set(float64(conns.len()))
+	}
+	return nil
+}
+
+// connectionModel returns how Workers are connected to the collector.
+func (m *Manager) connectionModel() string {
+	if m.conns == nil {
+		return ConnectionsPerWorker
+	}
+	return connectionModel(m.conns.len())
 }
 
 // AddWorkers adds n workers to the current pool of workers. Workers can be added at runtime.
 func (m *Manager) AddWorkers(n int) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	for i := 0; i < n; i++ {
-		w := m.newWorker(m.nWorkers)
+	m.addWorkers(n)
+}
+
+// SetWorkers scales the pool of workers to n workers, adding missing ones or retiring surplus ones.
+// Retired workers stop after finishing their current trace, the most recently added ones are retired first.
+// It only applies to closed-loop Workers, not to the Workers issuing arrivals in open-loop mode.
+func (m *Manager) SetWorkers(n int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if n < 0 {
+		n = 0
+	}
+	if n >= m.nWorkers {
+		m.addWorkers(n - m.nWorkers)
+		return
+	}
+	surplus := m.nWorkers - n
+	if surplus > len(m.workers) {
+		surplus = len(m.workers)
+	}
+	for _, w := range m.workers[len(m.workers)-surplus:] {
+		close(w.retired)
+	}
+	m.workers = m.workers[:len(m.workers)-surplus]
+	m.nWorkers -= surplus
+	m.logger.Println("RetireWorkers", surplus, m.nWorkers)
+}
 
+// addWorkers adds n workers. m.mu must be held by the caller.
+// Workers that cannot be initialized are counted as errors and left out.
+func (m *Manager) addWorkers(n int) {
+	added := 0
+	for i := 0; i < n; i++ {
+		w, err := m.newWorker(m.nextID)
+		m.nextID++
+		if err != nil {
+			m.errors++
+			m.logger.Printf("error adding worker: %v", err)
+			continue
+		}
+		added++
 		m.nWorkers++
 		m.newWorkers = append(m.newWorkers, w)
 		activeWorkers.WithLabelValues(m.name).Inc()
 	}
-	m.logger.Println("AddWorkers", n, m.nWorkers)
+	m.logger.Println("AddWorkers", added, m.nWorkers)
 	// We add all workers before starting them to make sure they are all properly initialized.
 	for _, w := range m.newWorkers {
 		go m.startAndWatch(m.ctx, w)
@@ -80,16 +219,31 @@ func (m *Manager) AddWorkers(n int) {
 	m.newWorkers = make([]*Worker, 0)
 }
 
-func (m *Manager) newWorker(id int) *Worker {
+// newWorker creates and initializes the Worker with the given ID.
+func (m *Manager) newWorker(id int) (*Worker, error) {
 	w := new(Worker)
 	w.managerName = m.name
 	w.ID = id
 	w.Config = m.config
 	w.Logger = log.New(m.logWriter, "W "+m.name+" ", log.Ltime|log.Lmicroseconds|log.LUTC)
-	ch := make(chan struct{}, 1)
-	w.FinishTrace = ch
-	w.initTracer(w.Config.Target)
-	return w
+	w.registry = m.registry
+	w.latencies = m.latencies
+	w.phase = m.phase
+	w.pauser = m.pauser
+	w.rand = newRand(m.seed, id)
+	w.pool = m.pool
+	w.retired = make(chan struct{})
+	w.tracker = m
+	if m.conns != nil {
+		m.conns.assign(w, id)
+	} else {
+		exporterConnections.WithLabelValues(m.name, ConnectionsPerWorker).Inc()
+	}
+	if err := w.init(); err != nil {
+		w.close(context.Background())
+		return nil, fmt.Errorf("error initializing worker %d: %v", id, err)
+	}
+	return w, nil
 }
 
 // startAndWatch is a simple wrapper that restarts a worker should it exit for any reason other than being canceled.
@@ -97,14 +251,20 @@ func (m *Manager) startAndWatch(ctx context.Context, w *Worker) {
 	for {
 		err := w.Run(ctx)
 		if err != nil {
-			if err == context.Canceled {
+			if errors.Is(err, context.Canceled) {
 				// We canceled this worker ourselves, so we should not restart it.
 				break
 			}
+			if errors.Is(err, errWorkerRetired) {
+				closeCtx, cancel := context.WithTimeout(context.Background(), closeTimeout)
+				w.close(closeCtx)
+				cancel()
+				break
+			}
 			m.mu.Lock()
 			m.errors += 1
 			m.mu.Unlock()
-			if err == context.DeadlineExceeded {
+			if errors.Is(err, context.DeadlineExceeded) {
 				// We don't need to log timeouts, the worker already does this.
 				continue
 			}
@@ -122,15 +282,272 @@ func (m *Manager) Start() {
 	if m.stopped {
 		return
 	}
+	m.logger.Println("Header", m.header())
+	// The shutdown function is set before returning, so that the Manager can be stopped right away.
+	shutdown, listenAndServe := m.receiver.Receive(m.finishTrace)
+	m.receiverShutdownFunc = shutdown
 	go func() {
-		shutdown, listenAndServe := m.receiver.ReceiveTraces(m.finishTrace)
-		m.receiverShutdownFunc = shutdown
 		if err := listenAndServe(); err != nil {
-			m.logger.Printf("error receiving traces: %v", err)
+			m.logger.Printf("error receiving: %v", err)
+		}
+	}()
+}
+
+// header describes the settings of the Manager that apply to every line of its log, as space separated key=value pairs.
+func (m *Manager) header() string {
+	compression := m.config.Compression
+	if compression == "" {
+		compression = "none"
+	}
+	timestamps := "realtime"
+	if m.config.SyntheticTimestamps {
+		timestamps = "synthetic"
+	}
+	pool := 0
+	if m.pool != nil {
+		pool = len(m.pool.payloads)
+	}
+	conns := 0
+	if m.conns != nil {
+		conns = m.conns.len()
+	}
+	return fmt.Sprintf("protocol=%s compression=%s tls=%t receiverTLS=%t timestamps=%s payloadPool=%d connections=%s connectionPoolSize=%d seed=%d",
+		protocol(m.config), compression, m.config.TLS.Enabled, m.config.ReceiverTLS.Enabled, timestamps, pool, m.connectionModel(), conns, m.seed)
+}
+
+// StartArrivals issues traces at a constant rate, independent of how fast they are received (open-loop).
+// Each arrival is handed to an idle Worker, which sends a single trace and returns to the pool afterwards.
+// Workers are created on demand until rate.MaxInFlight traces are outstanding, further arrivals are dropped.
+func (m *Manager) StartArrivals(rate config.ArrivalRate) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.stopped {
+		return ErrWorkerManagerStopped
+	}
+	if rate.Rate <= 0 {
+		return errors.New("arrival rate must be positive")
+	}
+	m.maxInFlight = rate.MaxInFlight
+	if m.maxInFlight <= 0 {
+		// At most this many traces can be outstanding before the oldest one times out.
+		timeout := m.config.SendTimeout.Seconds() + m.config.ReceiveTimeout.Seconds()
+		m.maxInFlight = int(math.Ceil(rate.Rate * timeout))
+		if m.maxInFlight < 1 {
+			m.maxInFlight = 1
+		}
+	}
+	m.arrivalWorkers = rate.Workers
+	if m.arrivalWorkers <= 0 {
+		m.arrivalWorkers = defaultArrivalWorkers
+	}
+	if m.arrivalWorkers > m.maxInFlight {
+		m.arrivalWorkers = m.maxInFlight
+	}
+	m.idle = make(chan *Worker, m.arrivalWorkers)
+	m.rate = rate.Rate
+	m.rateChanged = make(chan struct{}, 1)
+	m.rand = newRand(m.seed, seedIDArrivals)
+	m.logger.Println("StartArrivals", rate.Rate, rate.Poisson, m.maxInFlight, m.arrivalWorkers)
+	go m.arrive(m.ctx, rate)
+	return nil
+}
+
+// SetArrivalRate changes the number of arrivals per second once arrivals have been started.
+// A rate of 0 suspends arrivals. The number of outstanding traces stays limited as determined by StartArrivals.
+func (m *Manager) SetArrivalRate(rate float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if rate < 0 {
+		rate = 0
+	}
+	m.rate = rate
+	m.wakeArrivals()
+}
+
+// wakeArrivals makes arrive reschedule the next arrival after the rate changed or the Manager was paused. m.mu must be held.
+func (m *Manager) wakeArrivals() {
+	select {
+	case m.rateChanged <- struct{}{}:
+	default:
+	}
+}
+
+// arrive dispatches traces according to m.rate until ctx is canceled.
+// Arrival times are scheduled absolutely, so that slow dispatching does not lower the offered load.
+// When the rate changes, the next arrival is rescheduled relative to the last one.
+func (m *Manager) arrive(ctx context.Context, rate config.ArrivalRate) {
+	last := time.Now()
+	for {
+		if m.pauser.paused() {
+			select {
+			case <-ctx.Done():
+				m.retireIdle()
+				return
+			case <-m.pauser.resumed():
+			}
+			// Arrivals missed while paused are not made up for.
+			last = time.Now()
+		}
+		m.mu.RLock()
+		r := m.rate
+		m.mu.RUnlock()
+		var next time.Time
+		var wait <-chan time.Time
+		var t *time.Timer
+		if r > 0 {
+			next = last.Add(time.Duration(float64(time.Second) / r))
+			if rate.Poisson {
+				next = last.Add(time.Duration(m.rand.ExpFloat64() * float64(time.Second) / r))
+			}
+			t = time.NewTimer(time.Until(next))
+			wait = t.C
+		}
+		// While arrivals are suspended, wait blocks forever until the rate changes.
+		select {
+		case <-ctx.Done():
+			if t != nil {
+				t.Stop()
+			}
+			m.retireIdle()
+			return
+		case <-m.rateChanged:
+			if t != nil {
+				t.Stop()
+			}
+			if r <= 0 {
+				last = time.Now()
+			}
+			continue
+		case <-wait:
+		}
+		last = next
+		m.dispatch(ctx)
+	}
+}
+
+// dispatch hands a single arrival to an idle Worker, creating a new one if the pool is not exhausted yet.
+// The Worker is idle again as soon as it has sent the trace, which is awaited in the background until it is received or times out.
+func (m *Manager) dispatch(ctx context.Context) {
+	m.mu.Lock()
+	if m.inFlight >= m.maxInFlight {
+		m.mu.Unlock()
+		m.drop()
+		return
+	}
+	m.inFlight++
+	m.mu.Unlock()
+	tracesInFlight.WithLabelValues(m.name).Inc()
+
+	w, err := m.idleWorker()
+	if w == nil {
+		m.done(err)
+		if err == nil {
+			m.drop()
+		}
+		return
+	}
+	go func() {
+		await, err := w.SendOnce(ctx)
+		if ctx.Err() != nil {
+			// The Worker is not needed anymore.
+			activeWorkers.WithLabelValues(m.name).Dec()
+		} else {
+			m.idle <- w
+		}
+		if err == nil {
+			err = await()
 		}
+		m.done(err)
 	}()
 }
 
+// idleWorker returns an idle Worker for the next arrival, creating one outside of m.mu if there are less than m.arrivalWorkers.
+// It returns nil if all Workers are busy sending, or the error creating a new one.
+func (m *Manager) idleWorker() (*Worker, error) {
+	select {
+	case w := <-m.idle:
+		return w, nil
+	default:
+	}
+	m.mu.Lock()
+	if m.nArrivals >= m.arrivalWorkers {
+		m.mu.Unlock()
+		return nil, nil
+	}
+	id := m.nextID
+	m.nextID++
+	m.nArrivals++
+	m.mu.Unlock()
+
+	w, err := m.newWorker(id)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err != nil {
+		m.nArrivals--
+		m.logger.Printf("error adding worker: %v", err)
+		return nil, err
+	}
+	if m.stopped {
+		// The Manager was stopped while the Worker was created, so nobody else closes it.
+		w.close(context.Background())
+		return nil, ErrWorkerManagerStopped
+	}
+	m.arrivals = append(m.arrivals, w)
+	activeWorkers.WithLabelValues(m.name).Inc()
+	return w, nil
+}
+
+// done releases the slot of a trace that is no longer in flight, err is the error sending or awaiting it.
+func (m *Manager) done(err error) {
+	m.mu.Lock()
+	m.inFlight--
+	if err != nil && !errors.Is(err, context.Canceled) && !errors.Is(err, ErrWorkerManagerStopped) {
+		m.errors += 1
+	}
+	m.mu.Unlock()
+	tracesInFlight.WithLabelValues(m.name).Dec()
+}
+
+// acquire reserves one of m.slots for a trace a Worker awaits in the background, waiting while all of them are taken.
+func (m *Manager) acquire(ctx context.Context) error {
+	select {
+	case m.slots <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	m.mu.Lock()
+	m.inFlight++
+	m.mu.Unlock()
+	tracesInFlight.WithLabelValues(m.name).Inc()
+	return nil
+}
+
+// release frees the slot of a trace awaited in the background, err is the error sending or awaiting it.
+func (m *Manager) release(err error) {
+	m.done(err)
+	<-m.slots
+}
+
+// drop counts an arrival that was not sent.
+func (m *Manager) drop() {
+	m.mu.Lock()
+	m.dropped++
+	m.mu.Unlock()
+	arrivalsDropped.WithLabelValues(m.name, m.phase.get()).Inc()
+}
+
+// retireIdle removes all idle Workers from the active workers metric once arrivals have stopped.
+func (m *Manager) retireIdle() {
+	for {
+		select {
+		case <-m.idle:
+			activeWorkers.WithLabelValues(m.name).Dec()
+		default:
+			return
+		}
+	}
+}
+
 // Stop stops the manager and all its workers. A statusStopped manager cannot be reused.
 func (m *Manager) Stop() {
 	m.mu.Lock()
@@ -140,25 +557,69 @@ func (m *Manager) Stop() {
 	defer cancel()
 	m.receiverShutdownFunc(ctx)
 	time.Sleep(2 * time.Second) // Wait a short time so that all workers finish writing.
+	closeCtx, closeCancel := context.WithTimeout(context.Background(), closeTimeout)
+	defer closeCancel()
+	var wg sync.WaitGroup
+	for _, workers := range [][]*Worker{m.workers, m.arrivals} {
+		for _, w := range workers {
+			wg.Add(1)
+			go func(w *Worker) {
+				defer wg.Done()
+				w.close(closeCtx)
+			}(w)
+		}
+	}
+	wg.Wait()
+	if m.conns != nil {
+		m.conns.close()
+	}
+	exporterConnections.WithLabelValues(m.name, m.connectionModel()).Set(0)
 	m.nWorkers = 0
+	m.nArrivals = 0
 	m.stopped = true
 }
 
-// finishTrace notifies the worker with ID id that a trace was received so that it can stop it's timer.
+// finishTrace adds received spans to the trace with the given ID. Once complete, the Worker waiting for it is notified.
+// Spans of traces that are not in flight are logged as duplicated, late or unknown.
 // It returns ErrWorkerManagerStopped if the manager itself has statusStopped.
-func (m *Manager) finishTrace(id int) error {
-	if m.stopped {
+func (m *Manager) finishTrace(id trace.TraceID, v verification) error {
+	if m.ctx.Err() != nil {
 		return ErrWorkerManagerStopped
 	}
-	m.workers[id].FinishTrace <- struct{}{}
+	switch o := m.registry.receive(id, v, time.Now()); o {
+	case outcomeDuplicated, outcomeLate, outcomeUnknown:
+		m.logger.Printf("Unmatched %s %s %d", o, id, v.spans)
+	}
 	return nil
 }
 
 func (m *Manager) Status() Status {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
+	outcomes := m.registry.counts()
+	passed, failed := m.registry.verifications()
+	// Traces the collector sampled out are never returned, so they end up as lost traces.
+	var observed float64
+	returned := outcomes[outcomeComplete] + outcomes[outcomePartial]
+	if finished := returned + outcomes[outcomeLost]; finished > 0 {
+		observed = float64(returned) / float64(finished)
+	}
 	return Status{
-		ActiveWorkers: m.nWorkers,
-		Errors:        m.errors,
+		ActiveWorkers:   m.nWorkers + m.nArrivals,
+		Errors:          m.errors,
+		InFlight:        m.inFlight,
+		DroppedArrivals: m.dropped,
+		CompleteTraces:  outcomes[outcomeComplete],
+		PartialTraces:   outcomes[outcomePartial],
+		Duplicates:      outcomes[outcomeDuplicated],
+		LostTraces:      outcomes[outcomeLost],
+		LateArrivals:    outcomes[outcomeLate],
+		UnknownTraces:   outcomes[outcomeUnknown],
+
+		VerificationsPassed: passed,
+		VerificationsFailed: failed,
+
+		SamplingRatio:         m.config.Sampling.Ratio,
+		ObservedSamplingRatio: observed,
 	}
 }
@@ -14,21 +14,87 @@ var (
 	tracesSent = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "benchd_manager_traces_sent_count",
 		Help: "The total number of traces generated and sent by all workers",
-	}, []string{"name"})
+	}, []string{"name", "phase"})
 
 	tracesReceived = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "benchd_manager_traces_received_count",
 		Help: "The total number of traces received by all workers",
-	}, []string{"name"})
+	}, []string{"name", "phase"})
+
+	tracesComplete = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "benchd_manager_traces_complete_count",
+		Help: "The total number of traces of which all generated spans were received",
+	}, []string{"name", "phase"})
+
+	tracesPartial = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "benchd_manager_traces_partial_count",
+		Help: "The total number of traces of which only some spans were received before timing out",
+	}, []string{"name", "phase"})
+
+	tracesDuplicated = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "benchd_manager_traces_duplicated_count",
+		Help: "The total number of traces of which more spans were received than generated",
+	}, []string{"name", "phase"})
+
+	tracesLost = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "benchd_manager_traces_lost_count",
+		Help: "The total number of traces of which no span was received before timing out",
+	}, []string{"name", "phase"})
+
+	tracesVerified = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "benchd_manager_traces_verified_count",
+		Help: "The total number of received traces that were verified, by result (passed, failed)",
+	}, []string{"name", "result", "phase"})
+
+	tracesUnmatched = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "benchd_manager_traces_unmatched_count",
+		Help: "The total number of received traces that were not in flight, by kind (late, unknown)",
+	}, []string{"name", "kind", "phase"})
 
 	workerErrors = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "benchd_manager_worker_error_count",
 		Help: "The total number of errors that occurred in all workers",
-	}, []string{"name", "kind"})
+	}, []string{"name", "kind", "phase"})
+
+	tracesInFlight = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "benchd_manager_traces_in_flight_count",
+		Help: "The number of traces currently outstanding in open-loop mode",
+	}, []string{"name"})
+
+	arrivalsDropped = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "benchd_manager_arrivals_dropped_count",
+		Help: "The total number of open-loop arrivals dropped because too many traces were outstanding",
+	}, []string{"name", "phase"})
+
+	exporterConnections = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "benchd_manager_exporter_connections_count",
+		Help: "The number of connections to the collector, by connection model (per-worker, pool, shared)",
+	}, []string{"name", "model"})
+
+	signalsSent = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "benchd_manager_signals_sent_count",
+		Help: "The total number of requests generated and sent by all workers, by signal (traces, metrics, logs) and export protocol",
+	}, []string{"name", "signal", "protocol", "phase"})
+
+	signalsReceived = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "benchd_manager_signals_received_count",
+		Help: "The total number of requests received by all workers, by signal (traces, metrics, logs) and export protocol",
+	}, []string{"name", "signal", "protocol", "phase"})
+
+	signalRoundtrip = promauto.NewSummaryVec(prometheus.SummaryOpts{
+		Name:       "benchd_worker_signal_roundtrip_duration_seconds",
+		Help:       "The duration of a full request roundtrip from being sent to being finished, by signal (traces, metrics, logs) and export protocol",
+		Objectives: map[float64]float64{0.5: 0.05, 0.9: 0.01, 0.95: 0.005, 0.99: 0.001},
+	}, []string{"name", "signal", "protocol", "phase"})
+
+	currentPhase = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "benchd_manager_phase",
+		Help: "Set to 1 for the current phase of the benchmark (warmup, measurement, cooldown), 0 for all others",
+	}, []string{"name", "phase"})
 
 	traceRoundtrip = promauto.NewSummaryVec(prometheus.SummaryOpts{
 		Name:       "benchd_worker_trace_roundtrip_duration_seconds",
 		Help:       "The duration of trace full trace roundtrip from being sent to being finished",
 		Objectives: map[float64]float64{0.5: 0.05, 0.9: 0.01, 0.95: 0.005, 0.99: 0.001},
-	}, []string{"name"})
+	}, []string{"name", "phase"})
 )
@@ -0,0 +1,78 @@
+package worker
+
+import "sync"
+
+// pauser holds Workers between traces while their Manager is paused.
+type pauser struct {
+	mu sync.Mutex
+	// resumedC is closed while the Manager is running and replaced by an open channel while it is paused.
+	resumedC chan struct{}
+}
+
+func newPauser() *pauser {
+	c := make(chan struct{})
+	close(c)
+	return &pauser{resumedC: c}
+}
+
+// resumed returns a channel that is closed once the Manager is not paused.
+func (p *pauser) resumed() <-chan struct{} {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.resumedC
+}
+
+func (p *pauser) paused() bool {
+	select {
+	case <-p.resumed():
+		return false
+	default:
+		return true
+	}
+}
+
+// pause reports whether the Manager was paused by this call.
+func (p *pauser) pause() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	select {
+	case <-p.resumedC:
+		p.resumedC = make(chan struct{})
+		return true
+	default:
+		return false
+	}
+}
+
+// resume reports whether the Manager was resumed by this call.
+func (p *pauser) resume() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	select {
+	case <-p.resumedC:
+		return false
+	default:
+		close(p.resumedC)
+		return true
+	}
+}
+
+// Pause freezes all Workers once they finished their current trace and suspends open-loop arrivals.
+// Connections to the collector stay open, so that the same Workers continue once the Manager is resumed.
+func (m *Manager) Pause() {
+	if !m.pauser.pause() {
+		return
+	}
+	m.mu.RLock()
+	m.wakeArrivals()
+	m.mu.RUnlock()
+	m.logger.Println("Pause")
+}
+
+// Resume continues a paused Manager with the Workers it had before.
+func (m *Manager) Resume() {
+	if !m.pauser.resume() {
+		return
+	}
+	m.logger.Println("Resume")
+}
@@ -0,0 +1,307 @@
+package worker
+
+import (
+	"context"
+	crand "crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/ldb/openetelemtry-benchmark/config"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// exportTracesMethod is the gRPC method of the collector's OTLP trace receiver.
+const exportTracesMethod = "/opentelemetry.proto.collector.trace.v1.TraceService/Export"
+
+// payload is a single trace, encoded as OTLP ExportTraceServiceRequest in advance.
+// Its trace ID, span IDs and timestamps are patched in place before every send, so that every send is a new trace.
+type payload struct {
+	b []byte
+	// traceID holds the offsets of the trace ID in b, spanIDs the offsets of every span ID, including references by child spans.
+	traceID []int
+	spanIDs [][]int
+	// timestamps holds the offsets of every timestamp in b by its distance to the start of the trace.
+	timestamps map[time.Duration][]int
+	// length is the distance between the first and the last timestamp of the trace.
+	length time.Duration
+	// The values recorded while generating the trace.
+	spans               int
+	width               int
+	traceDepth          int
+	riskyAttributeDepth int
+	extraAttributes     int
+	spanLength          time.Duration
+	digest              uint64
+}
+
+// patch writes a new trace ID, new span IDs and timestamps relative to start into b, which must be a copy of p.b.
+func (p *payload) patch(b []byte, id trace.TraceID, start time.Time) {
+	for _, o := range p.traceID {
+		copy(b[o:], id[:])
+	}
+	var spanID trace.SpanID
+	for _, oo := range p.spanIDs {
+		_, _ = crand.Read(spanID[:])
+		for _, o := range oo {
+			copy(b[o:], spanID[:])
+		}
+	}
+	for d, oo := range p.timestamps {
+		t := uint64(start.Add(d).UnixNano())
+		for _, o := range oo {
+			binary.LittleEndian.PutUint64(b[o:], t)
+		}
+	}
+}
+
+// payloadPool holds pre-encoded traces shared by all Workers of a Manager.
+// Sending a payload only copies and patches it, so that benchd spends its resources on load instead of on generating it.
+type payloadPool struct {
+	payloads []*payload
+}
+
+// newPayloadPool generates c.PayloadPool.Size traces according to c.
+// Payloads are always encoded as protobuf, so ProtocolHTTPJSON is not supported.
+func newPayloadPool(name string, c config.WorkerConfig, r *rand.Rand) (*payloadPool, error) {
+	if p := protocol(c); p != ProtocolGRPC && p != ProtocolHTTPProtobuf {
+		return nil, fmt.Errorf("protocol %q is not supported by the payload pool", p)
+	}
+	p := &payloadPool{}
+	client := &captureClient{}
+	w := &Worker{managerName: name, Config: c, registry: newRegistry(name, 0), rand: r}
+	// The generated timestamps are replaced before sending anyway, so there is no point in waiting for them.
+	w.Config.SyntheticTimestamps = true
+	if err := w.initTracer(client); err != nil {
+		return nil, err
+	}
+	defer w.tracerProvider.Shutdown(context.Background())
+	for i := 0; i < c.PayloadPool.Size; i++ {
+		pl, err := w.generatePayload(client)
+		if err != nil {
+			return nil, fmt.Errorf("error generating payload: %v", err)
+		}
+		p.payloads = append(p.payloads, pl)
+	}
+	return p, nil
+}
+
+// generatePayload generates a single trace through the tracing SDK and encodes the spans captured by client.
+func (w *Worker) generatePayload(client *captureClient) (*payload, error) {
+	r := &record{}
+	w.generateTrace(r)
+	w.registry.cancel(r.traceID)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := w.tracerProvider.ForceFlush(ctx); err != nil {
+		return nil, err
+	}
+	return newPayload(client.take(), r)
+}
+
+// Full names of the fields of the encoded spans that are patched before every send.
+const (
+	spanTraceID      protoreflect.FullName = "opentelemetry.proto.trace.v1.Span.trace_id"
+	spanSpanID       protoreflect.FullName = "opentelemetry.proto.trace.v1.Span.span_id"
+	spanParentSpanID protoreflect.FullName = "opentelemetry.proto.trace.v1.Span.parent_span_id"
+	spanStartTime    protoreflect.FullName = "opentelemetry.proto.trace.v1.Span.start_time_unix_nano"
+	spanEndTime      protoreflect.FullName = "opentelemetry.proto.trace.v1.Span.end_time_unix_nano"
+	eventTime        protoreflect.FullName = "opentelemetry.proto.trace.v1.Span.Event.time_unix_nano"
+)
+
+// newPayload encodes the spans rs of the trace described by r.
+// The offsets of the IDs and timestamps are taken from the fields they are encoded in,
+// so that attribute values that happen to contain the same bytes are left alone.
+func newPayload(rs []*tracepb.ResourceSpans, r *record) (*payload, error) {
+	req := &coltracepb.ExportTraceServiceRequest{ResourceSpans: rs}
+	b, err := proto.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	p := &payload{
+		b:                   b,
+		timestamps:          make(map[time.Duration][]int),
+		spans:               r.spans,
+		width:               r.width,
+		traceDepth:          r.traceDepth,
+		riskyAttributeDepth: r.riskyAttributeDepth,
+		extraAttributes:     r.extraAttributes,
+		spanLength:          r.spanLength,
+		digest:              r.digest,
+	}
+	var start uint64
+	for _, s := range spans(rs) {
+		if start == 0 || s.StartTimeUnixNano < start {
+			start = s.StartTimeUnixNano
+		}
+	}
+	// A span ID is referenced by the span itself and by its children, which all have to be patched with the same new ID.
+	spanIDs := make(map[string]int)
+	err = walkFields(b, 0, req.ProtoReflect().Descriptor(), func(fd protoreflect.FieldDescriptor, o int, v []byte) {
+		switch fd.FullName() {
+		case spanTraceID:
+			p.traceID = append(p.traceID, o)
+		case spanSpanID, spanParentSpanID:
+			i, ok := spanIDs[string(v)]
+			if !ok {
+				i = len(p.spanIDs)
+				spanIDs[string(v)] = i
+				p.spanIDs = append(p.spanIDs, nil)
+			}
+			p.spanIDs[i] = append(p.spanIDs[i], o)
+		case spanStartTime, spanEndTime, eventTime:
+			d := time.Duration(binary.LittleEndian.Uint64(v) - start)
+			if d > p.length {
+				p.length = d
+			}
+			p.timestamps[d] = append(p.timestamps[d], o)
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error decoding payload: %v", err)
+	}
+	if len(p.traceID) != r.spans || len(p.spanIDs) != r.spans {
+		return nil, errors.New("trace was not exported completely")
+	}
+	return p, nil
+}
+
+// walkFields calls f for every field of the message md encoded in b, with the offset of its value, descending into nested messages.
+// offset is the offset of b in the full encoded message. The length prefix of bytes fields is not part of their value.
+func walkFields(b []byte, offset int, md protoreflect.MessageDescriptor, f func(fd protoreflect.FieldDescriptor, o int, v []byte)) error {
+	for i := 0; i < len(b); {
+		num, typ, n := protowire.ConsumeTag(b[i:])
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		i += n
+		n = protowire.ConsumeFieldValue(num, typ, b[i:])
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		v, o := b[i:i+n], offset+i
+		i += n
+		fd := md.Fields().ByNumber(num)
+		if fd == nil {
+			continue
+		}
+		if typ == protowire.BytesType {
+			// The value is preceded by its length.
+			vv, _ := protowire.ConsumeBytes(v)
+			o += len(v) - len(vv)
+			v = vv
+			if fd.Kind() == protoreflect.MessageKind {
+				if err := walkFields(v, o, fd.Message(), f); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		f(fd, o, v)
+	}
+	return nil
+}
+
+// next returns a random payload of the pool, chosen by r.
+func (p *payloadPool) next(r *rand.Rand) *payload {
+	return p.payloads[r.Intn(len(p.payloads))]
+}
+
+// sendPayload sends a copy of pl as trace id, which ends right now, over the connection of the Worker.
+func (w *Worker) sendPayload(ctx context.Context, pl *payload, id trace.TraceID) error {
+	b := make([]byte, len(pl.b))
+	copy(b, pl.b)
+	pl.patch(b, id, time.Now().Add(-pl.length))
+	if w.http != nil {
+		return w.http.post(ctx, "/v1/traces", b)
+	}
+	var resp []byte
+	return w.conn.Invoke(w.withHeaders(ctx), exportTracesMethod, b, &resp, grpc.ForceCodec(rawCodec{}))
+}
+
+// generateFromPool registers a trace based on a random payload and records its values in r.
+func (w *Worker) generateFromPool(r *record) (*payload, *flight) {
+	pl := w.pool.next(w.rand)
+	r.traceID = newCorrelationID()
+	f := w.registry.register(r.traceID, SignalTraces)
+	r.spans, r.width, r.traceDepth = pl.spans, pl.width, pl.traceDepth
+	r.riskyAttributeDepth, r.extraAttributes, r.spanLength, r.digest = pl.riskyAttributeDepth, pl.extraAttributes, pl.spanLength, pl.digest
+	w.registry.expect(r.traceID, r.spans, r.digest)
+	return pl, f
+}
+
+// captureClient collects the spans exported by the tracing SDK instead of sending them.
+type captureClient struct {
+	mu    sync.Mutex
+	spans []*tracepb.ResourceSpans
+}
+
+func (c *captureClient) Start(context.Context) error {
+	return nil
+}
+
+func (c *captureClient) Stop(context.Context) error {
+	return nil
+}
+
+func (c *captureClient) UploadTraces(_ context.Context, protoSpans []*tracepb.ResourceSpans) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.spans = append(c.spans, protoSpans...)
+	return nil
+}
+
+// take returns and forgets all spans captured so far.
+func (c *captureClient) take() []*tracepb.ResourceSpans {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	rs := c.spans
+	c.spans = nil
+	return rs
+}
+
+// spans returns all spans of rs.
+func spans(rs []*tracepb.ResourceSpans) []*tracepb.Span {
+	var ss []*tracepb.Span
+	for _, r := range rs {
+		for _, il := range r.InstrumentationLibrarySpans {
+			ss = append(ss, il.Spans...)
+		}
+	}
+	return ss
+}
+
+// rawCodec passes already encoded messages to gRPC unchanged.
+// It is named after the protobuf codec, so that the collector decodes the messages as usual.
+type rawCodec struct{}
+
+func (rawCodec) Marshal(v interface{}) ([]byte, error) {
+	b, ok := v.([]byte)
+	if !ok {
+		return nil, fmt.Errorf("rawCodec can not marshal %T", v)
+	}
+	return b, nil
+}
+
+func (rawCodec) Unmarshal(data []byte, v interface{}) error {
+	b, ok := v.(*[]byte)
+	if !ok {
+		return fmt.Errorf("rawCodec can not unmarshal into %T", v)
+	}
+	*b = append((*b)[:0], data...)
+	return nil
+}
+
+func (rawCodec) Name() string {
+	return "proto"
+}
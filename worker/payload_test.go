@@ -0,0 +1,90 @@
+package worker
+
+import (
+	"bytes"
+	"math"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestPayloadPatch(t *testing.T) {
+	traceID := []byte("0123456789abcdef")
+	rootID, childID := []byte("rootspan"), []byte("childspn")
+	start := uint64(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC).UnixNano())
+	ms := uint64(time.Millisecond)
+	// The attributes of the child hold the same bytes as the IDs and the start of the trace, which must not be patched.
+	attributes := []*commonpb.KeyValue{
+		{Key: "time", Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_DoubleValue{DoubleValue: math.Float64frombits(start)}}},
+		{Key: "ids", Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_BytesValue{BytesValue: append(append(append([]byte{}, traceID...), rootID...), childID...)}}},
+	}
+	rs := []*tracepb.ResourceSpans{{
+		InstrumentationLibrarySpans: []*tracepb.InstrumentationLibrarySpans{{
+			Spans: []*tracepb.Span{
+				{TraceId: traceID, SpanId: rootID, StartTimeUnixNano: start, EndTimeUnixNano: start + 10*ms},
+				{
+					TraceId:           traceID,
+					SpanId:            childID,
+					ParentSpanId:      rootID,
+					StartTimeUnixNano: start + ms,
+					EndTimeUnixNano:   start + 5*ms,
+					Events:            []*tracepb.Span_Event{{TimeUnixNano: start + 2*ms}},
+					Attributes:        attributes,
+				},
+			},
+		}},
+	}}
+	p, err := newPayload(rs, &record{spans: 2})
+	if err != nil {
+		t.Fatalf("error creating payload: %v", err)
+	}
+	if p.length != 10*time.Millisecond {
+		t.Errorf("length: got %v, want %v", p.length, 10*time.Millisecond)
+	}
+
+	id := trace.TraceID{1, 2, 3}
+	now := time.Now()
+	b := make([]byte, len(p.b))
+	copy(b, p.b)
+	p.patch(b, id, now)
+	req := new(coltracepb.ExportTraceServiceRequest)
+	if err := proto.Unmarshal(b, req); err != nil {
+		t.Fatalf("error decoding patched payload: %v", err)
+	}
+	ss := spans(req.ResourceSpans)
+	root, child := ss[0], ss[1]
+	for _, s := range ss {
+		if !bytes.Equal(s.TraceId, id[:]) {
+			t.Errorf("trace ID of span %x: got %x, want %x", s.SpanId, s.TraceId, id)
+		}
+	}
+	if bytes.Equal(root.SpanId, rootID) || bytes.Equal(child.SpanId, childID) {
+		t.Errorf("span IDs were not replaced: %x, %x", root.SpanId, child.SpanId)
+	}
+	if !bytes.Equal(child.ParentSpanId, root.SpanId) {
+		t.Errorf("parent span ID: got %x, want %x", child.ParentSpanId, root.SpanId)
+	}
+	n := uint64(now.UnixNano())
+	times := map[string][2]uint64{
+		"root start":  {root.StartTimeUnixNano, n},
+		"root end":    {root.EndTimeUnixNano, n + 10*ms},
+		"child start": {child.StartTimeUnixNano, n + ms},
+		"child end":   {child.EndTimeUnixNano, n + 5*ms},
+		"event":       {child.Events[0].TimeUnixNano, n + 2*ms},
+	}
+	for name, tt := range times {
+		if tt[0] != tt[1] {
+			t.Errorf("%s: got %d, want %d", name, tt[0], tt[1])
+		}
+	}
+	for i, a := range child.Attributes {
+		if !proto.Equal(a, attributes[i]) {
+			t.Errorf("attribute %s: got %v, want %v", a.Key, a.Value, attributes[i].Value)
+		}
+	}
+}
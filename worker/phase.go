@@ -0,0 +1,45 @@
+package worker
+
+import "sync/atomic"
+
+// Phases of a benchmark run. Only results of the measurement phase describe the steady state of the collector.
+const (
+	PhaseWarmup      = "warmup"
+	PhaseMeasurement = "measurement"
+	PhaseCooldown    = "cooldown"
+)
+
+// phase holds the current phase of a Manager, which is shared with its Workers and its registry.
+type phase struct {
+	v atomic.Value
+}
+
+// get returns the current phase, which is PhaseMeasurement unless another phase was set.
+func (p *phase) get() string {
+	if p == nil {
+		return PhaseMeasurement
+	}
+	if s, ok := p.v.Load().(string); ok {
+		return s
+	}
+	return PhaseMeasurement
+}
+
+func (p *phase) set(s string) {
+	p.v.Store(s)
+}
+
+// SetPhase marks the start of phase p in the log and labels all metrics recorded from now on with it.
+// Traces that are in flight while the phase changes are attributed to the phase they are finished in.
+func (m *Manager) SetPhase(p string) {
+	old := m.phase.get()
+	m.phase.set(p)
+	currentPhase.WithLabelValues(m.name, old).Set(0)
+	currentPhase.WithLabelValues(m.name, p).Set(1)
+	m.logger.Println("Phase", p)
+}
+
+// Phase returns the current phase of the Manager.
+func (m *Manager) Phase() string {
+	return m.phase.get()
+}
@@ -4,26 +4,40 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"github.com/ldb/openetelemtry-benchmark/config"
 	"go.opentelemetry.io/collector/model/otlp"
 	"go.opentelemetry.io/collector/model/pdata"
 	"log"
 	"net/http"
-	"strconv"
 	"strings"
 	"sync"
+
+	"go.opentelemetry.io/otel/trace"
 )
 
-// receiver is an HTTP server that accepts spans from the Openetelemetry Collector.
-// It discards the spans, parses the `service.name` attribute and notifies workers about received traces.
+// receiver is an HTTP server that accepts traces, metrics and logs from the Openetelemetry Collector.
+// It discards the data, counts spans, data points and log records per trace or correlation ID and notifies the Manager about them.
+// Only data with a `service.name` resource attribute starting with `benchd-worker` is considered.
+// Every span is checked by all Verifiers, and the digest of attributes with one of IntactPrefixes is computed.
+// Requests are routed by the suffix of their path (`/v1/metrics`, `/v1/logs`), all other requests are treated as traces.
+// Bodies compressed with gzip or zstd are decompressed. If TLS is enabled, the receiver serves HTTPS.
 type receiver struct {
-	Host string
-	um   pdata.TracesUnmarshaler
-	init sync.Once
+	Host           string
+	TLS            config.TLS
+	Verifiers      []Verifier
+	IntactPrefixes []string
+	traces         pdata.TracesUnmarshaler
+	metrics        pdata.MetricsUnmarshaler
+	logs           pdata.LogsUnmarshaler
+	init           sync.Once
 }
 
-func (r *receiver) ReceiveTraces(notify func(int) error) (func(ctx context.Context) error, func() error) {
+func (r *receiver) Receive(notify func(id trace.TraceID, v verification) error) (func(ctx context.Context) error, func() error) {
 	r.init.Do(func() {
-		r.um = otlp.NewProtobufTracesUnmarshaler()
+		r.traces = otlp.NewProtobufTracesUnmarshaler()
+		r.metrics = otlp.NewProtobufMetricsUnmarshaler()
+		r.logs = otlp.NewProtobufLogsUnmarshaler()
 	})
 
 	if notify == nil {
@@ -40,37 +54,31 @@ func (r *receiver) ReceiveTraces(notify func(int) error) (func(ctx context.Conte
 			return
 		}
 		request.Body.Close()
-		tt, err := r.um.UnmarshalTraces(body.Bytes())
+		b, err := decompress(request.Header.Get("Content-Encoding"), body.Bytes())
 		if err != nil {
-			log.Printf("error unmarshaling traces: %v", err)
+			log.Printf("error decompressing request body: %v", err)
 			writer.WriteHeader(http.StatusBadRequest)
 			return
 		}
 
-		for i := 0; i < tt.ResourceSpans().Len(); i++ {
-			e := tt.ResourceSpans().At(i)
-			r := e.Resource()
-			v, ok := r.Attributes().Get("service.name")
-			if !ok {
-				log.Printf(`could not find resource attribute "service.name"`)
-				continue
-			}
-			serviceName := v.AsString()
-			if !strings.HasPrefix(serviceName, "benchd-worker") {
-				continue
-			}
-			c := strings.Split(serviceName, ".")
-			if len(c) != 3 {
-				log.Printf(`malformed service.name attribute "%s"`, serviceName)
-			}
-			id, err := strconv.Atoi(c[2])
-			if err != nil {
-				log.Printf(`malformed id in service.name attribute "%s"`, serviceName)
-				continue
+		var received map[trace.TraceID]verification
+		switch {
+		case strings.HasSuffix(request.URL.Path, "/v1/metrics"):
+			received, err = r.receiveMetrics(b)
+		case strings.HasSuffix(request.URL.Path, "/v1/logs"):
+			received, err = r.receiveLogs(b)
+		default:
+			received, err = r.receiveTraces(b)
+		}
+		if err != nil {
+			log.Printf("error unmarshaling request to %s: %v", request.URL.Path, err)
+			writer.WriteHeader(http.StatusBadRequest)
+			return
+		}
 
-			}
-			if err := notify(id); err != nil {
-				log.Printf("error notifying worker with ID %d: %v", id, err)
+		for id, v := range received {
+			if err := notify(id, v); err != nil {
+				log.Printf("error notifying about trace %s: %v", id, err)
 				continue
 			}
 		}
@@ -78,5 +86,142 @@ func (r *receiver) ReceiveTraces(notify func(int) error) (func(ctx context.Conte
 	})
 
 	server := &http.Server{Addr: r.Host, Handler: handler}
-	return server.Shutdown, server.ListenAndServe
+	if !r.TLS.Enabled {
+		return server.Shutdown, server.ListenAndServe
+	}
+	tlsCfg, err := tlsConfig(r.TLS, true)
+	if err != nil {
+		return server.Shutdown, func() error { return fmt.Errorf("error configuring TLS: %v", err) }
+	}
+	server.TLSConfig = tlsCfg
+	return server.Shutdown, func() error { return server.ListenAndServeTLS("", "") }
+}
+
+// receiveTraces verifies all spans of a traces request by their trace ID.
+// Spans of one trace may be spread across several resources, so all of them are verified before notifying.
+func (r *receiver) receiveTraces(b []byte) (map[trace.TraceID]verification, error) {
+	tt, err := r.traces.UnmarshalTraces(b)
+	if err != nil {
+		return nil, err
+	}
+	traces := make(map[trace.TraceID]verification)
+	for i := 0; i < tt.ResourceSpans().Len(); i++ {
+		e := tt.ResourceSpans().At(i)
+		if !fromWorker(e.Resource()) {
+			continue
+		}
+		for j := 0; j < e.InstrumentationLibrarySpans().Len(); j++ {
+			ss := e.InstrumentationLibrarySpans().At(j).Spans()
+			for k := 0; k < ss.Len(); k++ {
+				id := trace.TraceID(ss.At(k).TraceID().Bytes())
+				traces[id] = r.verify(ss.At(k), traces[id])
+			}
+		}
+	}
+	return traces, nil
+}
+
+// receiveMetrics counts all data points of a metrics request by the correlation ID they carry.
+func (r *receiver) receiveMetrics(b []byte) (map[trace.TraceID]verification, error) {
+	md, err := r.metrics.UnmarshalMetrics(b)
+	if err != nil {
+		return nil, err
+	}
+	requests := make(map[trace.TraceID]verification)
+	count := func(attributes pdata.AttributeMap) {
+		v, ok := attributes.Get(correlationAttribute)
+		if !ok {
+			return
+		}
+		id, err := trace.TraceIDFromHex(v.StringVal())
+		if err != nil {
+			log.Printf("malformed %s attribute %q", correlationAttribute, v.StringVal())
+			return
+		}
+		c := requests[id]
+		c.spans++
+		requests[id] = c
+	}
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		if !fromWorker(rm.Resource()) {
+			continue
+		}
+		for j := 0; j < rm.InstrumentationLibraryMetrics().Len(); j++ {
+			ms := rm.InstrumentationLibraryMetrics().At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				m := ms.At(k)
+				switch m.DataType() {
+				case pdata.MetricDataTypeGauge:
+					for l := 0; l < m.Gauge().DataPoints().Len(); l++ {
+						count(m.Gauge().DataPoints().At(l).Attributes())
+					}
+				case pdata.MetricDataTypeSum:
+					for l := 0; l < m.Sum().DataPoints().Len(); l++ {
+						count(m.Sum().DataPoints().At(l).Attributes())
+					}
+				case pdata.MetricDataTypeHistogram:
+					for l := 0; l < m.Histogram().DataPoints().Len(); l++ {
+						count(m.Histogram().DataPoints().At(l).Attributes())
+					}
+				}
+			}
+		}
+	}
+	return requests, nil
+}
+
+// receiveLogs counts all log records of a logs request by the correlation ID they carry as trace ID.
+func (r *receiver) receiveLogs(b []byte) (map[trace.TraceID]verification, error) {
+	ld, err := r.logs.UnmarshalLogs(b)
+	if err != nil {
+		return nil, err
+	}
+	requests := make(map[trace.TraceID]verification)
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		if !fromWorker(rl.Resource()) {
+			continue
+		}
+		for j := 0; j < rl.InstrumentationLibraryLogs().Len(); j++ {
+			ll := rl.InstrumentationLibraryLogs().At(j).Logs()
+			for k := 0; k < ll.Len(); k++ {
+				id := trace.TraceID(ll.At(k).TraceID().Bytes())
+				c := requests[id]
+				c.spans++
+				requests[id] = c
+			}
+		}
+	}
+	return requests, nil
+}
+
+// fromWorker reports whether a resource was sent by a benchd Worker.
+func fromWorker(res pdata.Resource) bool {
+	v, ok := res.Attributes().Get("service.name")
+	if !ok {
+		log.Printf(`could not find resource attribute "service.name"`)
+		return false
+	}
+	return strings.HasPrefix(v.AsString(), "benchd-worker")
+}
+
+// verify adds a single span to the verification v of its trace.
+func (r *receiver) verify(span pdata.Span, v verification) verification {
+	v.spans++
+	for _, vf := range r.Verifiers {
+		if v.err != nil {
+			break
+		}
+		v.err = vf.Verify(span)
+	}
+	if len(r.IntactPrefixes) > 0 {
+		span.Attributes().Range(func(k string, value pdata.AttributeValue) bool {
+			if hasPrefix(k, r.IntactPrefixes) {
+				v.digest += attributeDigest(k, value.AsString())
+			}
+			return true
+		})
+	}
+	return v
 }
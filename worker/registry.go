@@ -0,0 +1,305 @@
+package worker
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// defaultFinishedCapacity is the number of finished traces the registry remembers to detect duplicates and late arrivals.
+const defaultFinishedCapacity = 100000
+
+// outcome describes what happened to a trace after it was sent.
+type outcome int
+
+const (
+	outcomeComplete   outcome = iota // All generated spans were received.
+	outcomePartial                   // Only some spans were received before the trace timed out.
+	outcomeDuplicated                // More spans were received than were generated.
+	outcomeLost                      // No span was received before the trace timed out.
+	outcomeLate                      // Spans were received after the trace had timed out.
+	outcomeUnknown                   // Spans were received for a trace that was never sent by this Manager or has been forgotten.
+	outcomeInFlight                  // Spans were received, but the trace is not complete yet.
+)
+
+var outcomeNames = [...]string{
+	"complete",
+	"partial",
+	"duplicated",
+	"lost",
+	"late",
+	"unknown",
+	"inflight",
+}
+
+func (o outcome) String() string {
+	return outcomeNames[o]
+}
+
+// Verification results of a trace as logged by a Worker.
+const (
+	verificationSkipped = -1
+	verificationFailed  = 0
+	verificationPassed  = 1
+)
+
+var verificationNames = map[int]string{
+	verificationFailed: "failed",
+	verificationPassed: "passed",
+}
+
+// receipt notifies a Worker that its trace has been received completely.
+type receipt struct {
+	t        time.Time // Time the last missing span was received.
+	spans    int       // Number of spans received.
+	verified int       // One of verificationSkipped, verificationFailed or verificationPassed.
+}
+
+// flight is a single trace that has been sent and waits to be received.
+// Metrics and logs requests are tracked as flights as well, using their data points or log records as spans.
+type flight struct {
+	traceID trace.TraceID
+	signal  string
+	spans   int // Number of spans generated for this trace, 0 while it is still being generated.
+	// receivedSpans is the number of spans received so far, possibly across several export batches.
+	receivedSpans int
+	// digest and receivedDigest are the attribute digests of the generated and the received spans.
+	digest         uint64
+	receivedDigest uint64
+	// verifyErr is the first verification error of any received span.
+	verifyErr error
+	received  chan receipt
+}
+
+// registry keeps track of all traces in flight, keyed by their trace ID.
+// It counts the spans received for each trace, so that traces split across several batches are only finished once complete.
+// Finished traces are remembered for a while, so that duplicates and late arrivals can be told apart from foreign traces.
+type registry struct {
+	name     string
+	mu       sync.Mutex
+	inFlight map[trace.TraceID]*flight
+	// finished maps recently finished traces to their outcome.
+	finished map[trace.TraceID]outcome
+	// order is a ring buffer of finished trace IDs used to evict the oldest ones.
+	order []trace.TraceID
+	next  int
+	// outcomes counts finished traces by their outcome.
+	outcomes map[outcome]int
+	// nSent counts traces that were sent successfully.
+	nSent int
+	// verify enables verification of completed traces, which are counted in verified by their result.
+	verify   bool
+	verified map[int]int
+	// onVerificationFailure is called for every trace that fails verification. r.mu is held while it is called.
+	onVerificationFailure func(id trace.TraceID, err error)
+	// phase labels the metrics of finished traces.
+	phase *phase
+}
+
+func newRegistry(name string, capacity int) *registry {
+	return &registry{
+		name:     name,
+		inFlight: make(map[trace.TraceID]*flight),
+		finished: make(map[trace.TraceID]outcome, capacity),
+		order:    make([]trace.TraceID, capacity),
+		outcomes: make(map[outcome]int),
+		verified: make(map[int]int),
+	}
+}
+
+// register adds a trace to the registry.
+// It must be called before any span of the trace can be exported, so that it can not be received before it is known.
+func (r *registry) register(id trace.TraceID, signal string) *flight {
+	f := &flight{traceID: id, signal: signal, received: make(chan receipt, 1)}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.inFlight[id] = f
+	return f
+}
+
+// expect records the number of spans and the attribute digest generated for a trace once its generation is complete.
+func (r *registry) expect(id trace.TraceID, spans int, digest uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	f, ok := r.inFlight[id]
+	if !ok {
+		return
+	}
+	f.spans = spans
+	f.digest = digest
+	// All spans may have been exported and received while the trace was still being generated.
+	if f.receivedSpans >= f.spans {
+		r.complete(f, time.Now())
+	}
+}
+
+// receive adds the spans received at time t to the trace with the given ID.
+// Once all generated spans have been received, the trace is verified, finished and its sender notified.
+// It returns the outcome of the trace, which is outcomeInFlight if spans are still missing.
+func (r *registry) receive(id trace.TraceID, v verification, t time.Time) outcome {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	f, ok := r.inFlight[id]
+	if !ok {
+		o, ok := r.finished[id]
+		switch {
+		case !ok:
+			r.count(outcomeUnknown)
+			return outcomeUnknown
+		case o == outcomeComplete:
+			r.finished[id] = outcomeDuplicated
+			r.count(outcomeDuplicated)
+			return outcomeDuplicated
+		case o == outcomePartial || o == outcomeLost:
+			r.finished[id] = outcomeLate
+			r.count(outcomeLate)
+			return outcomeLate
+		default:
+			// Further spans of a trace that was already counted as duplicated or late.
+			return o
+		}
+	}
+	f.receivedSpans += v.spans
+	f.receivedDigest += v.digest
+	if f.verifyErr == nil {
+		f.verifyErr = v.err
+	}
+	if f.spans == 0 || f.receivedSpans < f.spans {
+		return outcomeInFlight
+	}
+	return r.complete(f, t)
+}
+
+// expire finishes a trace that was not received completely in time.
+// It returns the number of spans received so far, and false if the trace was completed in the meantime.
+func (r *registry) expire(id trace.TraceID) (int, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	f, ok := r.inFlight[id]
+	if !ok {
+		return 0, false
+	}
+	o := outcomeLost
+	if f.receivedSpans > 0 {
+		o = outcomePartial
+	}
+	r.finish(id, o)
+	return f.receivedSpans, true
+}
+
+// cancel removes a trace without remembering it, for example because it could not be sent.
+func (r *registry) cancel(id trace.TraceID) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.inFlight, id)
+}
+
+// complete finishes a trace of which all spans have been received and notifies its sender.
+// r.mu must be held by the caller.
+func (r *registry) complete(f *flight, t time.Time) outcome {
+	o := outcomeComplete
+	if f.receivedSpans > f.spans {
+		o = outcomeDuplicated
+	}
+	r.finish(f.traceID, o)
+	f.received <- receipt{t: t, spans: f.receivedSpans, verified: r.verifyFlight(f)}
+	return o
+}
+
+// verifyFlight verifies a completed trace and counts the result.
+// r.mu must be held by the caller.
+func (r *registry) verifyFlight(f *flight) int {
+	if !r.verify || f.signal != SignalTraces {
+		return verificationSkipped
+	}
+	err := f.verifyErr
+	// The digests of duplicated traces differ, even if the attributes are intact.
+	if err == nil && f.receivedSpans == f.spans && f.receivedDigest != f.digest {
+		err = errors.New("intact attributes were modified or removed")
+	}
+	result := verificationPassed
+	if err != nil {
+		result = verificationFailed
+		if r.onVerificationFailure != nil {
+			r.onVerificationFailure(f.traceID, err)
+		}
+	}
+	r.verified[result]++
+	tracesVerified.WithLabelValues(r.name, verificationNames[result], r.phase.get()).Inc()
+	return result
+}
+
+// finish moves a trace from r.inFlight to r.finished, evicting the oldest finished trace if necessary.
+// r.mu must be held by the caller.
+func (r *registry) finish(id trace.TraceID, o outcome) {
+	delete(r.inFlight, id)
+	r.count(o)
+	if len(r.order) == 0 {
+		return
+	}
+	if old := r.order[r.next]; old.IsValid() {
+		delete(r.finished, old)
+	}
+	r.order[r.next] = id
+	r.next = (r.next + 1) % len(r.order)
+	r.finished[id] = o
+}
+
+// count counts a trace with outcome o.
+// r.mu must be held by the caller.
+func (r *registry) count(o outcome) {
+	r.outcomes[o]++
+	switch o {
+	case outcomeComplete:
+		tracesComplete.WithLabelValues(r.name, r.phase.get()).Inc()
+	case outcomePartial:
+		tracesPartial.WithLabelValues(r.name, r.phase.get()).Inc()
+	case outcomeDuplicated:
+		tracesDuplicated.WithLabelValues(r.name, r.phase.get()).Inc()
+	case outcomeLost:
+		tracesLost.WithLabelValues(r.name, r.phase.get()).Inc()
+	default:
+		tracesUnmatched.WithLabelValues(r.name, o.String(), r.phase.get()).Inc()
+	}
+}
+
+// markSent counts a trace that was sent successfully.
+func (r *registry) markSent() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.nSent++
+}
+
+// sent returns the number of traces sent successfully.
+func (r *registry) sent() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.nSent
+}
+
+// len returns the number of traces in flight.
+func (r *registry) len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.inFlight)
+}
+
+// verifications returns the number of verified traces that passed and failed.
+func (r *registry) verifications() (int, int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.verified[verificationPassed], r.verified[verificationFailed]
+}
+
+// counts returns the number of finished traces by outcome.
+func (r *registry) counts() map[outcome]int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c := make(map[outcome]int, len(r.outcomes))
+	for o, n := range r.outcomes {
+		c[o] = n
+	}
+	return c
+}
@@ -0,0 +1,32 @@
+package worker
+
+import "math/rand"
+
+// IDs used to derive the seeds of the sources of randomness that do not belong to a single Worker.
+const (
+	seedIDPayloadPool = -1
+	seedIDArrivals    = -2
+)
+
+// deriveSeed derives the seed of a single source of randomness, usually the one of the Worker with the given ID, from the seed of its Manager.
+// It applies the SplitMix64 finalizer, so that sources with consecutive IDs produce unrelated sequences.
+func deriveSeed(seed int64, id int) int64 {
+	z := uint64(seed) + uint64(id+3)*0x9e3779b97f4a7c15
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return int64(z ^ (z >> 31))
+}
+
+// newRand returns the source of randomness with the given ID for a Manager seeded with seed.
+func newRand(seed int64, id int) *rand.Rand {
+	return rand.New(rand.NewSource(deriveSeed(seed, id)))
+}
+
+// SetSeed seeds the generation of the workload, so that every Worker makes the same random decisions for the same seed.
+// The trace depths, attributes, span lengths and cool downs of each Worker then repeat from run to run, IDs and timestamps do not.
+// It must be called before Configure.
+func (m *Manager) SetSeed(seed int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.seed = seed
+}
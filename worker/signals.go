@@ -0,0 +1,216 @@
+package worker
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/model/otlpgrpc"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+)
+
+// Signals a Worker can generate.
+const (
+	SignalTraces  = "traces"
+	SignalMetrics = "metrics"
+	SignalLogs    = "logs"
+)
+
+// Kinds of metrics a Worker can generate.
+const (
+	MetricGauge     = "gauge"
+	MetricSum       = "sum"
+	MetricHistogram = "histogram"
+)
+
+// correlationAttribute is the data point attribute that carries the correlation ID of a metrics request.
+// Log records carry it as their trace ID instead.
+const correlationAttribute = "benchd.correlation_id"
+
+// defaultMetricKinds are generated if config.Metrics does not name any kinds.
+var defaultMetricKinds = []string{MetricGauge, MetricSum, MetricHistogram}
+
+// histogramBounds are the explicit bucket bounds of generated histograms.
+var histogramBounds = []float64{0.1, 0.5, 1, 5, 10}
+
+// initClients sets up the clients for metrics and logs, which are generated directly as pdata instead of through an SDK.
+// Over OTLP/HTTP, they are sent with w.http directly.
+func (w *Worker) initClients() {
+	if w.conn == nil {
+		return
+	}
+	w.metricsClient = otlpgrpc.NewMetricsClient(w.conn)
+	w.logsClient = otlpgrpc.NewLogsClient(w.conn)
+}
+
+// nextSignal picks the signal of the next request at random from all configured signals.
+func (w *Worker) nextSignal() string {
+	if len(w.Config.Signals) == 0 {
+		return SignalTraces
+	}
+	return w.Config.Signals[w.rand.Intn(len(w.Config.Signals))]
+}
+
+// hasSignal reports whether the Worker is configured to generate signal.
+func (w *Worker) hasSignal(signal string) bool {
+	if len(w.Config.Signals) == 0 {
+		return signal == SignalTraces
+	}
+	for _, s := range w.Config.Signals {
+		if s == signal {
+			return true
+		}
+	}
+	return false
+}
+
+// newCorrelationID returns a random ID that identifies a metrics or logs request in the registry.
+func newCorrelationID() trace.TraceID {
+	var id trace.TraceID
+	_, _ = rand.Read(id[:])
+	return id
+}
+
+// serviceName is the `service.name` resource attribute used by the receiver to recognize requests sent by benchd.
+func (w *Worker) serviceName() string {
+	return fmt.Sprintf("benchd-worker.%s.%d", w.managerName, w.ID)
+}
+
+// generateMetrics generates a new metrics request and registers it with w.registry.
+// Every data point carries the correlation ID of the request, so that it can be recognized once received.
+func (w *Worker) generateMetrics(r *record) (pdata.Metrics, *flight) {
+	r.traceID = newCorrelationID()
+	f := w.registry.register(r.traceID, SignalMetrics)
+	kinds := w.Config.Metrics.Kinds
+	if len(kinds) == 0 {
+		kinds = defaultMetricKinds
+	}
+	now := pdata.NewTimestampFromTime(time.Now())
+	md := pdata.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().InsertString("service.name", w.serviceName())
+	ilm := rm.InstrumentationLibraryMetrics().AppendEmpty()
+	ilm.InstrumentationLibrary().SetName(fmt.Sprintf("M:%s-W:%d", w.managerName, w.ID))
+	n := 1 + w.rand.Intn(max(w.Config.Metrics.MaxDataPoints, 1))
+	for i := 0; i < n; i++ {
+		kind := kinds[w.rand.Intn(len(kinds))]
+		m := ilm.Metrics().AppendEmpty()
+		m.SetName(fmt.Sprintf("benchd.%s.%d", kind, i))
+		var attributes pdata.AttributeMap
+		switch strings.ToLower(kind) {
+		case MetricSum:
+			m.SetDataType(pdata.MetricDataTypeSum)
+			m.Sum().SetIsMonotonic(true)
+			m.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+			dp := m.Sum().DataPoints().AppendEmpty()
+			dp.SetTimestamp(now)
+			dp.SetIntVal(w.rand.Int63n(1000))
+			attributes = dp.Attributes()
+		case MetricHistogram:
+			m.SetDataType(pdata.MetricDataTypeHistogram)
+			m.Histogram().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+			dp := m.Histogram().DataPoints().AppendEmpty()
+			dp.SetTimestamp(now)
+			counts := make([]uint64, len(histogramBounds)+1)
+			var total uint64
+			for b := range counts {
+				counts[b] = uint64(w.rand.Intn(100))
+				total += counts[b]
+			}
+			dp.SetExplicitBounds(histogramBounds)
+			dp.SetBucketCounts(counts)
+			dp.SetCount(total)
+			dp.SetSum(w.rand.Float64() * float64(total))
+			attributes = dp.Attributes()
+		default:
+			m.SetDataType(pdata.MetricDataTypeGauge)
+			dp := m.Gauge().DataPoints().AppendEmpty()
+			dp.SetTimestamp(now)
+			dp.SetDoubleVal(w.rand.Float64())
+			attributes = dp.Attributes()
+		}
+		attributes.InsertString(correlationAttribute, r.traceID.String())
+		r.extraAttributes += w.insertExtraAttributes(attributes)
+	}
+	r.spans = n
+	w.registry.expect(r.traceID, r.spans, 0)
+	return md, f
+}
+
+// generateLogs generates a new logs request and registers it with w.registry.
+// Every log record carries the correlation ID of the request as its trace ID, so that it can be recognized once received.
+func (w *Worker) generateLogs(r *record) (pdata.Logs, *flight) {
+	r.traceID = newCorrelationID()
+	f := w.registry.register(r.traceID, SignalLogs)
+	body := strings.Repeat("x", w.Config.Logs.BodySize)
+	now := pdata.NewTimestampFromTime(time.Now())
+	ld := pdata.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().InsertString("service.name", w.serviceName())
+	ill := rl.InstrumentationLibraryLogs().AppendEmpty()
+	ill.InstrumentationLibrary().SetName(fmt.Sprintf("M:%s-W:%d", w.managerName, w.ID))
+	n := 1 + w.rand.Intn(max(w.Config.Logs.MaxRecords, 1))
+	for i := 0; i < n; i++ {
+		lr := ill.Logs().AppendEmpty()
+		lr.SetTimestamp(now)
+		lr.SetTraceID(pdata.NewTraceID(r.traceID))
+		lr.SetSeverityNumber(pdata.SeverityNumberINFO)
+		lr.SetSeverityText("INFO")
+		lr.Body().SetStringVal(body)
+		r.extraAttributes += w.insertExtraAttributes(lr.Attributes())
+	}
+	r.spans = n
+	w.registry.expect(r.traceID, r.spans, 0)
+	return ld, f
+}
+
+// insertExtraAttributes inserts up to w.Config.MaxExtraAttributes attributes and returns how many were inserted.
+func (w *Worker) insertExtraAttributes(attributes pdata.AttributeMap) int {
+	if w.Config.MaxExtraAttributes <= 0 {
+		return 0
+	}
+	a := w.rand.Intn(w.Config.MaxExtraAttributes)
+	for i := 0; i <= a; i++ {
+		attributes.InsertInt(fmt.Sprintf("extraAttribute-%d", i), int64(i))
+	}
+	return a
+}
+
+func (w *Worker) sendMetrics(ctx context.Context, md pdata.Metrics) error {
+	req := otlpgrpc.NewMetricsRequest()
+	req.SetMetrics(md)
+	if w.http != nil {
+		return w.http.export(ctx, "/v1/metrics", req)
+	}
+	_, err := w.metricsClient.Export(w.withHeaders(ctx), req)
+	return err
+}
+
+func (w *Worker) sendLogs(ctx context.Context, ld pdata.Logs) error {
+	req := otlpgrpc.NewLogsRequest()
+	req.SetLogs(ld)
+	if w.http != nil {
+		return w.http.export(ctx, "/v1/logs", req)
+	}
+	_, err := w.logsClient.Export(w.withHeaders(ctx), req)
+	return err
+}
+
+// withHeaders adds the configured headers to the metadata of an outgoing gRPC request.
+func (w *Worker) withHeaders(ctx context.Context) context.Context {
+	if len(w.Config.Headers) == 0 {
+		return ctx
+	}
+	return metadata.NewOutgoingContext(ctx, metadata.New(w.Config.Headers))
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
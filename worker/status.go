@@ -5,4 +5,29 @@ type Status struct {
 	ActiveWorkers int `json:"activeWorkers"`
 	// Number of errors occurred in all workers thus far.
 	Errors int `json:"errors"`
+	// Number of traces currently outstanding in open-loop mode.
+	InFlight int `json:"inFlight"`
+	// Number of open-loop arrivals that were dropped because too many traces were outstanding.
+	DroppedArrivals int `json:"droppedArrivals"`
+	// Number of traces of which all generated spans were received.
+	// Metrics and logs requests are counted as traces, with their data points and log records as spans.
+	CompleteTraces int `json:"completeTraces"`
+	// Number of traces of which only some spans were received before timing out.
+	PartialTraces int `json:"partialTraces"`
+	// Number of traces of which more spans were received than generated.
+	Duplicates int `json:"duplicates"`
+	// Number of traces of which no span was received before timing out.
+	LostTraces int `json:"lostTraces"`
+	// Number of traces that were received after they had already timed out.
+	LateArrivals int `json:"lateArrivals"`
+	// Number of traces that were received but not sent by this Manager, for example from a previous benchmark.
+	UnknownTraces int `json:"unknownTraces"`
+	// Number of received traces that were transformed as expected by the collector.
+	VerificationsPassed int `json:"verificationsPassed"`
+	// Number of received traces that were not transformed as expected by the collector.
+	VerificationsFailed int `json:"verificationsFailed"`
+	// Ratio of traces the collector is configured to return when sampling.
+	SamplingRatio float64 `json:"samplingRatio"`
+	// Ratio of finished traces that were actually returned by the collector.
+	ObservedSamplingRatio float64 `json:"observedSamplingRatio"`
 }
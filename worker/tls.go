@@ -0,0 +1,49 @@
+package worker
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/ldb/openetelemtry-benchmark/config"
+)
+
+// tlsConfig creates the client or server side tls.Config described by c. It returns nil if TLS is not enabled.
+func tlsConfig(c config.TLS, server bool) (*tls.Config, error) {
+	if !c.Enabled {
+		return nil, nil
+	}
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if c.CertFile != "" || c.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("error loading certificate: %v", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	var pool *x509.CertPool
+	if c.CAFile != "" {
+		pem, err := ioutil.ReadFile(c.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading CA file: %v", err)
+		}
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in CA file %q", c.CAFile)
+		}
+	}
+	if server {
+		if len(cfg.Certificates) == 0 {
+			return nil, fmt.Errorf("serving TLS requires a certificate")
+		}
+		if pool != nil {
+			cfg.ClientCAs = pool
+			cfg.ClientAuth = tls.RequireAndVerifyClientCert
+		}
+		return cfg, nil
+	}
+	cfg.RootCAs = pool
+	cfg.InsecureSkipVerify = c.InsecureSkipVerify
+	return cfg, nil
+}
@@ -0,0 +1,50 @@
+package worker
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ldb/openetelemtry-benchmark/config"
+)
+
+// ValidateConfig returns an error for settings of c that Workers cannot run with,
+// such as an unknown protocol, signal or compression, a payload pool the protocol does not support, or TLS material that cannot be loaded.
+// The files of the TLS material are read again when the Workers are created.
+func ValidateConfig(c config.WorkerConfig) error {
+	switch p := protocol(c); p {
+	case ProtocolGRPC, ProtocolHTTPProtobuf, ProtocolHTTPJSON:
+	default:
+		return fmt.Errorf("unknown protocol %q", p)
+	}
+	if p := protocol(c); c.PayloadPool.Size > 0 && p != ProtocolGRPC && p != ProtocolHTTPProtobuf {
+		return fmt.Errorf("protocol %q is not supported by the payload pool", p)
+	}
+	switch c.Compression {
+	case "", CompressionGzip, CompressionZstd:
+	default:
+		return fmt.Errorf("unknown compression %q", c.Compression)
+	}
+	traces := len(c.Signals) == 0
+	for _, s := range c.Signals {
+		switch s {
+		case SignalTraces:
+			traces = true
+		case SignalMetrics, SignalLogs:
+		default:
+			return fmt.Errorf("unknown signal %q", s)
+		}
+	}
+	if traces && c.MaxTraceDepth <= 0 {
+		return errors.New("maxTraceDepth must be positive")
+	}
+	if traces && c.MaxSpanLength.Milliseconds() <= 0 {
+		return errors.New("maxSpanLength must be at least 1ms")
+	}
+	if _, err := tlsConfig(c.TLS, false); err != nil {
+		return fmt.Errorf("invalid tls: %v", err)
+	}
+	if _, err := tlsConfig(c.ReceiverTLS, true); err != nil {
+		return fmt.Errorf("invalid receiverTLS: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package worker
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strings"
+
+	"github.com/ldb/openetelemtry-benchmark/config"
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+// Verifier checks that a span received from the collector was transformed as expected.
+// It returns an error describing the first unexpected transformation it finds.
+type Verifier interface {
+	Verify(span pdata.Span) error
+}
+
+// VerifierFunc is an adapter to allow the use of ordinary functions as Verifier.
+type VerifierFunc func(span pdata.Span) error
+
+func (f VerifierFunc) Verify(span pdata.Span) error {
+	return f(span)
+}
+
+// AbsentAttributes returns a Verifier that fails if a span still carries any of the given attributes.
+func AbsentAttributes(keys ...string) Verifier {
+	return VerifierFunc(func(span pdata.Span) error {
+		for _, k := range keys {
+			if _, ok := span.Attributes().Get(k); ok {
+				return fmt.Errorf("attribute %q was not removed from span %q", k, span.Name())
+			}
+		}
+		return nil
+	})
+}
+
+// verifiersFrom creates all span Verifiers described by a config.Verification.
+func verifiersFrom(c config.Verification) []Verifier {
+	var vv []Verifier
+	if len(c.AbsentAttributes) > 0 {
+		vv = append(vv, AbsentAttributes(c.AbsentAttributes...))
+	}
+	return vv
+}
+
+// verification is the verification result of all spans of one trace received in a single batch.
+type verification struct {
+	spans int
+	// digest is the attributeDigest of all attributes matching config.Verification.IntactAttributePrefixes.
+	digest uint64
+	// err is the first error returned by any Verifier, if any.
+	err error
+}
+
+// attributeDigest returns an order independent digest of a single attribute.
+// The digests of all attributes of a trace are summed up, so that the sender and the receiver can compare them cheaply.
+func attributeDigest(key, value string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(key))
+	h.Write([]byte{'='})
+	h.Write([]byte(value))
+	return h.Sum64()
+}
+
+// hasPrefix reports whether key starts with any of prefixes.
+func hasPrefix(key string, prefixes []string) bool {
+	for _, p := range prefixes {
+		if strings.HasPrefix(key, p) {
+			return true
+		}
+	}
+	return false
+}
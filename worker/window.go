@@ -0,0 +1,158 @@
+package worker
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	// Roundtrips are counted in exponential buckets starting at minLatency, with latencySubBuckets buckets per doubling.
+	// Percentiles are therefore accurate to about 9%, which is plenty to compare them against thresholds.
+	minLatency        = 10 * time.Microsecond
+	latencySubBuckets = 8
+	latencyBuckets    = 30 * latencySubBuckets
+)
+
+// latencies counts the roundtrip durations of all received traces of a Manager.
+// The counts only ever grow, so that the percentiles of any interval can be computed from two copies of them.
+type latencies struct {
+	mu     sync.Mutex
+	counts [latencyBuckets]int
+}
+
+func (l *latencies) observe(d time.Duration) {
+	i := 0
+	if d > minLatency {
+		i = int(math.Ceil(math.Log2(float64(d)/float64(minLatency)) * latencySubBuckets))
+	}
+	if i >= latencyBuckets {
+		i = latencyBuckets - 1
+	}
+	l.mu.Lock()
+	l.counts[i]++
+	l.mu.Unlock()
+}
+
+func (l *latencies) snapshot() [latencyBuckets]int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.counts
+}
+
+// bucketLatency returns the upper bound of bucket i.
+func bucketLatency(i int) time.Duration {
+	return time.Duration(float64(minLatency) * math.Exp2(float64(i)/latencySubBuckets))
+}
+
+// Snapshot holds the counters of a Manager at a point in time.
+// The difference of two Snapshots describes the traces finished in between, see Snapshot.Since.
+type Snapshot struct {
+	Time time.Time
+	// Sent, Errors, Received, Timeouts and Dropped count all traces so far, see Window.
+	Sent      int
+	Errors    int
+	Received  int
+	Timeouts  int
+	Dropped   int
+	latencies [latencyBuckets]int
+}
+
+// Window describes the traces finished between two Snapshots.
+type Window struct {
+	Duration time.Duration `json:"duration"`
+	// Number of traces sent successfully.
+	Sent int `json:"sent"`
+	// Number of errors while sending or receiving, including receive timeouts.
+	Errors int `json:"errors"`
+	// Number of traces of which all spans were received.
+	Received int `json:"received"`
+	// Number of traces of which not all spans were received before timing out.
+	Timeouts int `json:"timeouts"`
+	// Number of open-loop arrivals that were dropped because too many traces were outstanding.
+	Dropped int `json:"dropped"`
+	// Sent traces per second.
+	SendRate float64 `json:"sendRate"`
+	// Received traces per second.
+	Throughput float64 `json:"throughput"`
+	// Percentiles of the roundtrip duration of received traces.
+	P50 time.Duration `json:"p50"`
+	P90 time.Duration `json:"p90"`
+	P99 time.Duration `json:"p99"`
+
+	latencies [latencyBuckets]int
+}
+
+// Since returns the Window between prev and s.
+func (s Snapshot) Since(prev Snapshot) Window {
+	w := Window{
+		Duration: s.Time.Sub(prev.Time),
+		Sent:     s.Sent - prev.Sent,
+		Errors:   s.Errors - prev.Errors,
+		Received: s.Received - prev.Received,
+		Timeouts: s.Timeouts - prev.Timeouts,
+		Dropped:  s.Dropped - prev.Dropped,
+	}
+	for i := range w.latencies {
+		w.latencies[i] = s.latencies[i] - prev.latencies[i]
+	}
+	if w.Duration > 0 {
+		w.SendRate = float64(w.Sent) / w.Duration.Seconds()
+		w.Throughput = float64(w.Received) / w.Duration.Seconds()
+	}
+	w.P50, w.P90, w.P99 = w.Percentile(0.5), w.Percentile(0.9), w.Percentile(0.99)
+	return w
+}
+
+// Percentile returns the roundtrip duration below which the fraction q of received traces fall, or 0 if none were received.
+func (w Window) Percentile(q float64) time.Duration {
+	var total int
+	for _, c := range w.latencies {
+		total += c
+	}
+	if total == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(q * float64(total)))
+	var n int
+	for i, c := range w.latencies {
+		n += c
+		if n >= rank {
+			return bucketLatency(i)
+		}
+	}
+	return bucketLatency(latencyBuckets - 1)
+}
+
+// ErrorRate returns the ratio of failed and dropped traces to all traces finished or dropped in w.
+func (w Window) ErrorRate() float64 {
+	failed := w.Errors + w.Dropped
+	if total := failed + w.Received; total > 0 {
+		return float64(failed) / float64(total)
+	}
+	return 0
+}
+
+// TimeoutRatio returns the ratio of timed out traces to all traces finished in w.
+func (w Window) TimeoutRatio() float64 {
+	if total := w.Timeouts + w.Received; total > 0 {
+		return float64(w.Timeouts) / float64(total)
+	}
+	return 0
+}
+
+// Snapshot returns the current counters of the Manager.
+func (m *Manager) Snapshot() Snapshot {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	outcomes := m.registry.counts()
+	return Snapshot{
+		Time:      time.Now(),
+		Sent:      m.registry.sent(),
+		Errors:    m.errors,
+		Received:  outcomes[outcomeComplete],
+		Timeouts:  outcomes[outcomePartial] + outcomes[outcomeLost],
+		Dropped:   m.dropped,
+		latencies: m.latencies.snapshot(),
+	}
+}
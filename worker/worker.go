@@ -2,18 +2,21 @@ package worker
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/ldb/openetelemtry-benchmark/config"
+	"go.opentelemetry.io/collector/model/otlpgrpc"
+	"go.opentelemetry.io/collector/model/pdata"
 	"go.opentelemetry.io/otel/attribute"
-	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
 	"go.opentelemetry.io/otel/sdk/resource"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	semconv "go.opentelemetry.io/otel/semconv/v1.7.0"
-	"log"
 	"math/rand"
 	"time"
 
 	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
 )
 
 type status int
@@ -25,8 +28,19 @@ const (
 	statusSendError
 	statusReceiveTimeout
 	statusStopped
+	statusPartial    // Only some spans of the trace were received before the receive timeout.
+	statusSampledOut // The trace was not received, but the collector is expected to sample traces.
 )
 
+// tracker limits and counts the traces a Worker awaits in the background in Async mode, it is implemented by the Manager.
+type tracker interface {
+	acquire(ctx context.Context) error
+	release(err error)
+}
+
+// errWorkerRetired is returned by Worker.Run once the Worker was retired by its Manager.
+var errWorkerRetired = errors.New("worker retired")
+
 type Logger interface {
 	Println(m ...interface{})
 	Printf(format string, v ...interface{})
@@ -40,10 +54,33 @@ type Worker struct {
 
 	tracer         trace.Tracer
 	tracerProvider *sdktrace.TracerProvider
-	FinishTrace    chan struct{} // Manager notifies the worker on this channel that it can stop recording the current trace
+	conn           *grpc.ClientConn // Connection used for all signals over OTLP/gRPC.
+	metricsClient  otlpgrpc.MetricsClient
+	logsClient     otlpgrpc.LogsClient
+	http           *httpClient   // Client used for all signals over OTLP/HTTP.
+	sharedConn     bool          // The connection is shared with other Workers and must not be closed by this one.
+	retired        chan struct{} // Closed by the Manager to stop the Worker after its current trace.
+	registry       *registry     // Traces are registered here before being sent, the Manager finishes them once they are received.
+	pool           *payloadPool  // Pre-generated traces shared by all Workers of the Manager, nil if traces are generated through the SDK.
+	latencies      *latencies    // Roundtrip durations of received traces, shared by all Workers of the Manager.
+	phase          *phase        // Current phase of the benchmark, shared by all Workers of the Manager.
+	pauser         *pauser       // Holds the Worker between traces while the Manager is paused.
+	rand           *rand.Rand    // Source of all random decisions of the Worker, derived from the seed of the Manager.
+	tracker        tracker       // Accounts for traces awaited in the background in Async mode.
 	Logger         Logger
+}
 
-	// recorded Values
+// record holds the recorded values of a single trace.
+// A Worker creates a new record for every trace, so that it can have several traces in flight at once.
+type record struct {
+	signal              string
+	traceID             trace.TraceID // Trace ID, or correlation ID for metrics and logs.
+	spans               int           // Number of spans generated.
+	width               int           // Largest number of spans on a single level of the trace.
+	clock               time.Time     // Synthetic time while generating the trace, zero in realtime mode.
+	receivedSpans       int           // Number of spans received.
+	digest              uint64        // Digest of all attributes that are expected to be returned intact.
+	verified            int           // Verification result of the received trace.
 	traceDepth          int
 	riskyAttributeDepth int
 	extraAttributes     int
@@ -56,24 +93,39 @@ type Worker struct {
 	sentReceivedD       time.Duration // Delta between sendET and receiveT
 }
 
-func (w *Worker) initTracer(target string) {
-	exporter := otlptracegrpc.NewUnstarted(
-		otlptracegrpc.WithInsecure(),
-		otlptracegrpc.WithEndpoint(target),
-	)
+// init connects the Worker to the collector for all signals it is configured to generate.
+func (w *Worker) init() error {
+	if err := w.connect(); err != nil {
+		return fmt.Errorf("error setting up connection: %v", err)
+	}
+	if w.hasSignal(SignalTraces) && w.pool == nil {
+		if err := w.initTracer(w.newTraceClient()); err != nil {
+			return err
+		}
+	}
+	if w.hasSignal(SignalMetrics) || w.hasSignal(SignalLogs) {
+		w.initClients()
+	}
+	w.log(&record{signal: "none", verified: verificationSkipped}, statusInitialized)
+	return nil
+}
+
+// initTracer sets up the tracing SDK to export traces through client.
+func (w *Worker) initTracer(client otlptrace.Client) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
-	err := exporter.Start(ctx)
+	exporter, err := otlptrace.New(ctx, client)
 	if err != nil {
-		log.Fatalf("setup exporter: %v", err.Error())
+		return fmt.Errorf("error setting up exporter: %v", err)
 	}
 	res, err := resource.New(ctx,
 		resource.WithAttributes(
-			semconv.ServiceNameKey.String(fmt.Sprintf("benchd-worker.%s.%d", w.managerName, w.ID)),
+			semconv.ServiceNameKey.String(w.serviceName()),
 		),
 	)
 	if err != nil {
-		log.Fatalf("create resource: %v", err.Error())
+		_ = exporter.Shutdown(ctx)
+		return fmt.Errorf("error creating resource: %v", err)
 	}
 
 	tp := sdktrace.NewTracerProvider(
@@ -82,135 +134,361 @@ func (w *Worker) initTracer(target string) {
 	)
 	w.tracer = tp.Tracer(fmt.Sprintf("M:%s-W:%d", w.managerName, w.ID))
 	w.tracerProvider = tp
-	w.log(statusInitialized)
+	return nil
 }
 
 func (w *Worker) Run(ctx context.Context) error {
 	for {
+		// Wait while the Manager is paused.
+		select {
+		case <-w.pauser.resumed():
+		case <-w.retired:
+		case <-ctx.Done():
+		}
+		select {
+		case <-w.retired:
+			activeWorkers.WithLabelValues(w.managerName).Dec()
+			return errWorkerRetired
+		default:
+		}
 		// w.run should not be inlined here as to avoid a defer loop.
-		err := w.run(ctx)
+		err := w.run(ctx, true)
 		if err != nil {
-			if err != context.Canceled {
-				e := err.Error()
-				if len(e) >= 100 {
-					// Read only 100 first chars of error message because of label cardinality
-					e = e[:100]
-				}
-				workerErrors.WithLabelValues(w.managerName, e).Inc()
-			}
+			w.countError(err)
 			return err
 		}
 	}
 }
 
-func (w *Worker) run(ctx context.Context) error {
-	w.startT = time.Now()
-	w.generateTrace()
-	defer w.reset()
+// SendOnce generates and sends a single trace without cooling down afterwards.
+// It is used in open-loop mode, where the Manager decides when the next trace is sent:
+// the Worker can send the next trace as soon as SendOnce returns, while the returned function awaits the sent one.
+func (w *Worker) SendOnce(ctx context.Context) (await func() error, err error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("worker cancelled: %w", err)
+	}
+	r, f, err := w.send()
+	if err != nil {
+		w.countError(err)
+		return nil, err
+	}
+	return func() error {
+		err := w.await(ctx, r, f, 0)
+		if err != nil {
+			w.countError(err)
+		}
+		return err
+	}, nil
+}
+
+func (w *Worker) countError(err error) {
+	if errors.Is(err, context.Canceled) {
+		return
+	}
+	e := err.Error()
+	if len(e) >= 100 {
+		// Read only 100 first chars of error message because of label cardinality
+		e = e[:100]
+	}
+	workerErrors.WithLabelValues(w.managerName, e, w.phase.get()).Inc()
+}
+
+func (w *Worker) run(ctx context.Context, coolDown bool) error {
+	if err := ctx.Err(); err != nil {
+		// Only reachable in async mode, where the Worker does not wait for its traces to be canceled.
+		activeWorkers.WithLabelValues(w.managerName).Dec()
+		return fmt.Errorf("worker cancelled: %w", err)
+	}
+	async := w.Config.Sampling.Async
+	if async {
+		if err := w.tracker.acquire(ctx); err != nil {
+			activeWorkers.WithLabelValues(w.managerName).Dec()
+			return fmt.Errorf("worker cancelled: %w", err)
+		}
+	}
+	r, f, err := w.send()
+	if err != nil {
+		if async {
+			// The error is counted once returned, there is nothing to await.
+			w.tracker.release(nil)
+		}
+		return err
+	}
+	var cool time.Duration
+	if ms := w.Config.MaxCoolDown.Milliseconds(); coolDown && ms > 0 {
+		cool = time.Duration(w.rand.Int63n(ms)) * time.Millisecond
+	}
+	if async {
+		// The trace is awaited in the background, so that traces sampled out by the collector do not hold up the Worker.
+		go func() {
+			err := w.await(ctx, r, f, 0)
+			if err != nil {
+				w.countError(err)
+			}
+			w.tracker.release(err)
+		}()
+		time.Sleep(cool)
+		return nil
+	}
+	if err := w.await(ctx, r, f, cool); err != nil {
+		if errors.Is(err, context.Canceled) {
+			activeWorkers.WithLabelValues(w.managerName).Dec()
+		}
+		return err
+	}
+	time.Sleep(cool)
+	return nil
+}
+
+// send generates the next request of the Worker and sends it, returning its record and the flight to await it with.
+func (w *Worker) send() (*record, *flight, error) {
+	r := &record{signal: w.nextSignal(), startT: time.Now(), verified: verificationSkipped}
+	var f *flight
+	var send func(ctx context.Context) error
+	switch r.signal {
+	case SignalMetrics:
+		var md pdata.Metrics
+		md, f = w.generateMetrics(r)
+		send = func(ctx context.Context) error { return w.sendMetrics(ctx, md) }
+	case SignalLogs:
+		var ld pdata.Logs
+		ld, f = w.generateLogs(r)
+		send = func(ctx context.Context) error { return w.sendLogs(ctx, ld) }
+	default:
+		if w.pool != nil {
+			var pl *payload
+			pl, f = w.generateFromPool(r)
+			send = func(ctx context.Context) error { return w.sendPayload(ctx, pl, r.traceID) }
+			break
+		}
+		f = w.generateTrace(r)
+		send = w.tracerProvider.ForceFlush
+	}
 	sendTimeout, cancelSend := context.WithTimeout(context.Background(), w.Config.SendTimeout.Duration)
 	defer cancelSend()
-	w.sendT = time.Now()
-	if err := w.tracerProvider.ForceFlush(sendTimeout); err != nil {
-		w.receiveT = time.Now()
-		w.sentReceivedD = w.receiveT.Sub(w.sendT)
-		if err != context.DeadlineExceeded {
-			w.log(statusSendError)
-			return fmt.Errorf("error flushing trace: %w", err)
+	r.sendT = time.Now()
+	if err := send(sendTimeout); err != nil {
+		w.registry.cancel(r.traceID)
+		r.receiveT = time.Now()
+		r.sentReceivedD = r.receiveT.Sub(r.sendT)
+		if sendTimeout.Err() != context.DeadlineExceeded {
+			w.log(r, statusSendError)
+			return nil, nil, fmt.Errorf("error sending %s: %w", r.signal, err)
 		}
-		w.log(statusSendTimeout)
-		return fmt.Errorf("send timeout: %w", sendTimeout.Err())
+		w.log(r, statusSendTimeout)
+		return nil, nil, fmt.Errorf("send timeout: %w", sendTimeout.Err())
 	}
-	w.sendET = time.Now()
-	tracesSent.WithLabelValues(w.managerName).Inc()
+	r.sendET = time.Now()
+	w.registry.markSent()
+	signalsSent.WithLabelValues(w.managerName, r.signal, protocol(w.Config), w.phase.get()).Inc()
+	if r.signal == SignalTraces {
+		tracesSent.WithLabelValues(w.managerName, w.phase.get()).Inc()
+	}
+	return r, f, nil
+}
+
+// await waits until the trace recorded in r is received or times out and logs the result.
+// If the collector is expected to sample traces, a trace that is never received is not an error.
+func (w *Worker) await(ctx context.Context, r *record, f *flight, coolDown time.Duration) error {
 	receiveTimeout, cancelReceive := context.WithTimeout(context.Background(), w.Config.ReceiveTimeout.Duration)
 	defer cancelReceive()
 	select {
 	case <-ctx.Done():
-		activeWorkers.WithLabelValues(w.managerName).Dec()
-		w.log(statusStopped)
-		return fmt.Errorf("worker cancelled: %v", ctx.Err())
+		w.registry.cancel(r.traceID)
+		w.log(r, statusStopped)
+		return fmt.Errorf("worker cancelled: %w", ctx.Err())
 
 	case <-receiveTimeout.Done():
-		w.receiveT = time.Now()
-		w.sentReceivedD = w.receiveT.Sub(w.sendET)
-		w.log(statusReceiveTimeout)
-		return fmt.Errorf("receive timeout: %w", sendTimeout.Err())
-
-	case <-w.FinishTrace:
-		w.receiveT = time.Now()
-		w.sentReceivedD = w.receiveT.Sub(w.sendET)
-		tracesReceived.WithLabelValues(w.managerName).Inc()
-		cooldown := time.Duration(rand.Int63n(w.Config.MaxCoolDown.Milliseconds())) * time.Millisecond
-		w.coolDown = cooldown
-		w.log(statusSuccess)
-		traceRoundtrip.WithLabelValues(w.managerName).Observe(w.sentReceivedD.Seconds())
-		time.Sleep(cooldown)
+		if received, ok := w.registry.expire(r.traceID); ok {
+			r.receivedSpans = received
+			r.receiveT = time.Now()
+			r.sentReceivedD = r.receiveT.Sub(r.sendET)
+			if received > 0 {
+				w.log(r, statusPartial)
+				return fmt.Errorf("receive timeout: partial trace: %w", receiveTimeout.Err())
+			}
+			if w.Config.Sampling.Ratio > 0 {
+				r.coolDown = coolDown
+				w.log(r, statusSampledOut)
+				return nil
+			}
+			w.log(r, statusReceiveTimeout)
+			return fmt.Errorf("receive timeout: %w", receiveTimeout.Err())
+		}
+		// The trace was completed right as the timeout fired.
+		rc := <-f.received
+		r.receiveT, r.receivedSpans, r.verified = rc.t, rc.spans, rc.verified
+
+	case rc := <-f.received:
+		r.receiveT, r.receivedSpans, r.verified = rc.t, rc.spans, rc.verified
+	}
+	r.sentReceivedD = r.receiveT.Sub(r.sendET)
+	r.coolDown = coolDown
+	w.log(r, statusSuccess)
+	phase := w.phase.get()
+	signalsReceived.WithLabelValues(w.managerName, r.signal, protocol(w.Config), phase).Inc()
+	signalRoundtrip.WithLabelValues(w.managerName, r.signal, protocol(w.Config), phase).Observe(r.sentReceivedD.Seconds())
+	w.latencies.observe(r.sentReceivedD)
+	if r.signal == SignalTraces {
+		tracesReceived.WithLabelValues(w.managerName, phase).Inc()
+		traceRoundtrip.WithLabelValues(w.managerName, phase).Observe(r.sentReceivedD.Seconds())
 	}
 	return nil
 }
 
-// log logs the last request to w.Logger.
-func (w *Worker) log(s status) {
-	w.Logger.Println(fmt.Sprintf("%d %d %d %d %d %d %d %d %d %d %d %d",
+// log logs the recorded values of a trace to w.Logger.
+func (w *Worker) log(r *record, s status) {
+	w.Logger.Println(fmt.Sprintf("%d %d %d %d %d %d %d %d %d %d %d %d %s %d %d %d %s %d %s",
 		w.ID,                           // worker ID
 		int(s),                         // worker status code
-		w.traceDepth,                   // trace depth
-		w.riskyAttributeDepth,          // depth of risky attribute
-		w.extraAttributes,              // number of extra attributes in trace
-		w.spanLength.Milliseconds(),    // accumulated spanlength
-		w.coolDown.Milliseconds(),      // cooldown
-		w.startT.UnixMilli(),           // start worker
-		w.sendT.UnixMilli(),            // start sending payload
-		w.sendET.UnixMilli(),           // end sending
-		w.receiveT.UnixMilli(),         // receive response
-		w.sentReceivedD.Milliseconds(), // delta end sending and receive
+		r.traceDepth,                   // trace depth
+		r.riskyAttributeDepth,          // depth of risky attribute
+		r.extraAttributes,              // number of extra attributes in trace
+		r.spanLength.Milliseconds(),    // accumulated spanlength
+		r.coolDown.Milliseconds(),      // cooldown
+		r.startT.UnixMilli(),           // start worker
+		r.sendT.UnixMilli(),            // start sending payload
+		r.sendET.UnixMilli(),           // end sending
+		r.receiveT.UnixMilli(),         // receive response
+		r.sentReceivedD.Milliseconds(), // delta end sending and receive
+		r.traceID,                      // trace ID
+		r.spans,                        // number of spans generated
+		r.receivedSpans,                // number of spans received
+		r.verified,                     // verification result: -1 skipped, 0 failed, 1 passed
+		r.signal,                       // signal: traces, metrics or logs
+		r.width,                        // largest number of spans on a single level of the trace
+		w.phase.get(),                  // phase of the benchmark: warmup, measurement or cooldown
 	))
 }
 
-func (w *Worker) generateTrace() {
-	d := rand.Intn(w.Config.MaxTraceDepth)
-	w.traceDepth = d
-	ctx, trace := w.tracer.Start(context.Background(), "parentTrace")
+// generateTrace generates a new trace and records its values in r.
+// The trace is registered with w.registry before any of its spans end, so that it is known before it can be exported.
+func (w *Worker) generateTrace(r *record) *flight {
+	d := w.rand.Intn(w.Config.MaxTraceDepth)
+	r.traceDepth = d
+	if w.Config.SyntheticTimestamps {
+		r.clock = time.Now()
+	}
+	ctx, trace := w.tracer.Start(context.Background(), "parentTrace", w.startAt(r)...)
+	r.traceID = trace.SpanContext().TraceID()
+	f := w.registry.register(r.traceID, SignalTraces)
+	r.spans = 1
+	r.width = 1
 	riskyAtDepth := 0
-	if w.Config.RiskyAttributeProbability > 0 && d > 0 && rand.Intn(100) <= w.Config.RiskyAttributeProbability {
-		riskyAtDepth = rand.Intn(d)
+	if w.Config.RiskyAttributeProbability > 0 && d > 0 && w.rand.Intn(100) <= w.Config.RiskyAttributeProbability {
+		riskyAtDepth = w.rand.Intn(d)
+	}
+	w.children(ctx, r, d, riskyAtDepth)
+	trace.End(w.endAt(r)...)
+	w.registry.expect(r.traceID, r.spans, r.digest)
+	return f
+}
+
+// node is a span that may get children.
+type node struct {
+	ctx  context.Context
+	span trace.Span
+}
+
+// children generates the tree of spans below the root span of a trace, one level at a time.
+// Going breadth first, w.Config.MaxNumberSpans limits the depth of wide trees instead of cutting off all but their first branches.
+// Levels are numbered by their remaining depth, counting down from maxDepth to 1, the risky attribute is added to the first span of level riskyAtDepth.
+func (w *Worker) children(ctx context.Context, r *record, maxDepth, riskyAtDepth int) {
+	parents := []node{{ctx: ctx}}
+	var spans []trace.Span
+	for level, depth := 0, maxDepth; len(parents) > 0; level, depth = level+1, depth-1 {
+		var next []node
+		for _, p := range parents {
+			n := 1 + w.rand.Intn(w.fanOut(level))
+			for i := 0; i < n && w.spanBudgetLeft(r); i++ {
+				if i == 0 && p.span != nil {
+					w.setAttribute(p.span, r, attribute.Bool("hasChildren", true))
+					opts := []trace.EventOption{trace.WithAttributes(attribute.Int("maxDepth", depth+1))}
+					if w.Config.SyntheticTimestamps {
+						opts = append(opts, trace.WithTimestamp(r.clock))
+					}
+					p.span.AddEvent("spawning child", opts...)
+				}
+				c := w.child(p.ctx, r, depth, depth == riskyAtDepth && len(next) == 0)
+				spans = append(spans, c.span)
+				next = append(next, c)
+			}
+		}
+		if len(next) > r.width {
+			r.width = len(next)
+		}
+		if depth <= 1 {
+			break
+		}
+		parents = next
+	}
+	// Children end before their parents.
+	for i := len(spans) - 1; i >= 0; i-- {
+		spans[i].End(w.endAt(r)...)
 	}
-	w.child(ctx, d, riskyAtDepth)
-	trace.End()
 }
 
-func (w *Worker) child(ctx context.Context, maxDepth, riskyAtDepth int) {
-	cctx, sp := w.tracer.Start(ctx, fmt.Sprintf("worker.%d.child.%d", w.ID, maxDepth))
-	sl := time.Duration(rand.Int63n(w.Config.MaxSpanLength.Milliseconds())) * time.Millisecond
+func (w *Worker) child(ctx context.Context, r *record, depth int, risky bool) node {
+	cctx, sp := w.tracer.Start(ctx, fmt.Sprintf("worker.%d.child.%d", w.ID, depth), w.startAt(r)...)
+	r.spans++
+	sl := time.Duration(w.rand.Int63n(w.Config.MaxSpanLength.Milliseconds())) * time.Millisecond
 	if w.Config.MaxExtraAttributes > 0 {
-		a := rand.Intn(w.Config.MaxExtraAttributes)
+		a := w.rand.Intn(w.Config.MaxExtraAttributes)
 		for i := 0; i <= a; i++ {
-			sp.SetAttributes(attribute.Int(fmt.Sprintf("extraAttribute-%d", i), i))
+			w.setAttribute(sp, r, attribute.Int(fmt.Sprintf("extraAttribute-%d", i), i))
 		}
-		w.extraAttributes += a
-	}
-	if riskyAtDepth == maxDepth {
-		w.riskyAttributeDepth = riskyAtDepth
-		sp.SetAttributes(attribute.Int("risky", w.ID))
-	}
-	w.spanLength += sl
-	time.Sleep(sl)
-	defer sp.End()
-	if maxDepth > 1 {
-		sp.SetAttributes(attribute.Bool("hasChildren", true))
-		sp.AddEvent("spawning child", trace.WithAttributes(attribute.Int("maxDepth", maxDepth)))
-		w.child(cctx, maxDepth-1, riskyAtDepth)
-	}
-}
-
-func (w *Worker) reset() {
-	w.traceDepth = 0
-	w.riskyAttributeDepth = 0
-	w.extraAttributes = 0
-	w.spanLength = 0
-	w.coolDown = 0
-	w.startT = time.Time{}
-	w.sendT = time.Time{}
-	w.receiveT = time.Time{}
-	w.sentReceivedD = 0
+		r.extraAttributes += a
+	}
+	if risky {
+		r.riskyAttributeDepth = depth
+		w.setAttribute(sp, r, attribute.Int("risky", w.ID))
+	}
+	r.spanLength += sl
+	if w.Config.SyntheticTimestamps {
+		r.clock = r.clock.Add(sl)
+	} else {
+		time.Sleep(sl)
+	}
+	return node{ctx: cctx, span: sp}
+}
+
+// startAt returns the option to start a span at the synthetic time of r, or none in realtime mode.
+func (w *Worker) startAt(r *record) []trace.SpanStartOption {
+	if !w.Config.SyntheticTimestamps {
+		return nil
+	}
+	return []trace.SpanStartOption{trace.WithTimestamp(r.clock)}
+}
+
+// endAt returns the option to end a span at the synthetic time of r, or none in realtime mode.
+// All spans of a trace end at the time its last span was generated, so that every span contains its children.
+func (w *Worker) endAt(r *record) []trace.SpanEndOption {
+	if !w.Config.SyntheticTimestamps {
+		return nil
+	}
+	return []trace.SpanEndOption{trace.WithTimestamp(r.clock)}
+}
+
+// fanOut returns the maximum number of children of a span at the given level of a trace, the root span being at level 0.
+func (w *Worker) fanOut(level int) int {
+	if len(w.Config.MaxFanOut) == 0 {
+		return 1
+	}
+	if level >= len(w.Config.MaxFanOut) {
+		level = len(w.Config.MaxFanOut) - 1
+	}
+	return max(w.Config.MaxFanOut[level], 1)
+}
+
+// spanBudgetLeft reports whether another span can be added to the trace recorded in r without exceeding w.Config.MaxNumberSpans.
+func (w *Worker) spanBudgetLeft(r *record) bool {
+	return w.Config.MaxNumberSpans <= 0 || r.spans < w.Config.MaxNumberSpans
+}
+
+// setAttribute sets a single attribute on sp and adds it to the digest of r if it is expected to be returned intact.
+func (w *Worker) setAttribute(sp trace.Span, r *record, kv attribute.KeyValue) {
+	sp.SetAttributes(kv)
+	if hasPrefix(string(kv.Key), w.Config.Verification.IntactAttributePrefixes) {
+		r.digest += attributeDigest(string(kv.Key), kv.Value.Emit())
+	}
 }